@@ -3,6 +3,8 @@
 
 package pi
 
+import "math"
+
 // Area specifies rectangular boundaries on a [Surface].
 //
 // It is used by [Sprite], [SetClip], [Surface.CloneArea], [Surface.LinesIterator], and others.
@@ -83,3 +85,136 @@ func (a Area[T]) ClippedBy(clip Area[T]) (_ Area[T], dx, dy T) {
 func (a Area[T]) Contains(x, y T) bool {
 	return x >= a.X && x < a.X+a.W && y >= a.Y && y < a.Y+a.H
 }
+
+// ContainsArea reports whether the other area is entirely inside the Area.
+//
+// Empty areas (with zero or negative width or height) are never contained.
+func (a Area[T]) ContainsArea(other Area[T]) bool {
+	if other.W <= 0 || other.H <= 0 {
+		return false
+	}
+	return other.X >= a.X && other.X+other.W <= a.X+a.W &&
+		other.Y >= a.Y && other.Y+other.H <= a.Y+a.H
+}
+
+// Intersect returns the common part of both areas.
+//
+// If the areas do not overlap, the returned area has zero width or height.
+func (a Area[T]) Intersect(other Area[T]) Area[T] {
+	intersection, _, _ := a.ClippedBy(other)
+	return intersection
+}
+
+// Overlaps reports whether both areas have a common part.
+//
+// Areas which only touch each other do not overlap.
+func (a Area[T]) Overlaps(other Area[T]) bool {
+	return a.X < other.X+other.W && other.X < a.X+a.W &&
+		a.Y < other.Y+other.H && other.Y < a.Y+a.H &&
+		a.W > 0 && a.H > 0 && other.W > 0 && other.H > 0
+}
+
+// Union returns the smallest area containing both areas.
+//
+// Empty areas (with zero or negative width or height) are ignored.
+func (a Area[T]) Union(other Area[T]) Area[T] {
+	if other.W <= 0 || other.H <= 0 {
+		return a
+	}
+	if a.W <= 0 || a.H <= 0 {
+		return other
+	}
+	x0, y0 := min(a.X, other.X), min(a.Y, other.Y)
+	x1, y1 := max(a.X+a.W, other.X+other.W), max(a.Y+a.H, other.Y+other.H)
+	return Area[T]{X: x0, Y: y0, W: x1 - x0, H: y1 - y0}
+}
+
+// Expand returns a new Area enlarged by d on each side.
+func (a Area[T]) Expand(d T) Area[T] {
+	a.X -= d
+	a.Y -= d
+	a.W += d + d
+	a.H += d + d
+	return a
+}
+
+// Inset returns a new Area shrunk by d on each side.
+//
+// Width and height never become negative.
+func (a Area[T]) Inset(d T) Area[T] {
+	a.X += d
+	a.Y += d
+	a.W = max(a.W-d-d, 0)
+	a.H = max(a.H-d-d, 0)
+	return a
+}
+
+// Center returns the center point of the Area.
+//
+// For integer areas the result is rounded towards the top-left corner.
+func (a Area[T]) Center() (x, y T) {
+	return a.X + a.W/2, a.Y + a.H/2
+}
+
+// Corners returns the top-left (x0, y0) and bottom-right (x1, y1) corners.
+//
+// The bottom-right corner is not inside the area. For IntArea,
+// the last pixel inside the area is (x1-1, y1-1).
+func (a Area[T]) Corners() (x0, y0, x1, y1 T) {
+	return a.X, a.Y, a.X + a.W, a.Y + a.H
+}
+
+// Sweep checks whether the Area moved by (dx, dy) hits the other area.
+//
+// It returns the time of impact - the fraction of the movement (0..1)
+// after which both areas start to overlap. If areas already overlap,
+// it returns 0. Areas which only touch each other at the end
+// of the movement do not hit.
+//
+// Moving the area by (dx*toi, dy*toi) places it right next to the other area.
+func (a Area[T]) Sweep(other Area[T], dx, dy T) (toi float64, hit bool) {
+	if a.W <= 0 || a.H <= 0 || other.W <= 0 || other.H <= 0 {
+		return 0, false
+	}
+
+	entryX, exitX, ok := sweepAxis(float64(a.X), float64(a.W), float64(other.X), float64(other.W), float64(dx))
+	if !ok {
+		return 0, false
+	}
+	entryY, exitY, ok := sweepAxis(float64(a.Y), float64(a.H), float64(other.Y), float64(other.H), float64(dy))
+	if !ok {
+		return 0, false
+	}
+
+	entry := max(entryX, entryY)
+	exit := min(exitX, exitY)
+	if entry >= exit || entry >= 1 || exit <= 0 {
+		return 0, false
+	}
+
+	return max(entry, 0), true
+}
+
+// sweepAxis returns the times when the moving segment (pos, size)
+// starts and stops overlapping the static segment (otherPos, otherSize).
+func sweepAxis(pos, size, otherPos, otherSize, d float64) (entry, exit float64, ok bool) {
+	switch {
+	case d > 0:
+		return (otherPos - pos - size) / d, (otherPos + otherSize - pos) / d, true
+	case d < 0:
+		return (otherPos + otherSize - pos) / d, (otherPos - pos - size) / d, true
+	case pos < otherPos+otherSize && otherPos < pos+size:
+		return math.Inf(-1), math.Inf(1), true
+	default:
+		return 0, 0, false
+	}
+}
+
+// ConvertArea converts the Area to an Area with a different number type,
+// for example IntArea to Area[float64].
+//
+// Values are converted using Go conversion rules, so floating-point
+// values are truncated towards zero when converted to integers.
+func ConvertArea[To Number, From Number](a Area[From]) Area[To] {
+	return Area[To]{X: To(a.X), Y: To(a.Y), W: To(a.W), H: To(a.H)}
+}
@@ -0,0 +1,135 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pi_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elgopher/pi"
+	"github.com/elgopher/pi/pimath"
+)
+
+func TestArea_ContainsArea(t *testing.T) {
+	a := pi.IntArea{X: 1, Y: 1, W: 4, H: 4}
+	assert.True(t, a.ContainsArea(a))
+	assert.True(t, a.ContainsArea(pi.IntArea{X: 2, Y: 2, W: 3, H: 3}))
+	assert.False(t, a.ContainsArea(pi.IntArea{X: 2, Y: 2, W: 4, H: 3}))
+	assert.False(t, a.ContainsArea(pi.IntArea{X: 0, Y: 2, W: 1, H: 1}))
+	assert.False(t, a.ContainsArea(pi.IntArea{X: 2, Y: 2}))
+}
+
+func TestArea_Intersect(t *testing.T) {
+	a := pi.IntArea{X: 1, Y: 1, W: 4, H: 4}
+	assert.Equal(t, pi.IntArea{X: 3, Y: 2, W: 2, H: 3}, a.Intersect(pi.IntArea{X: 3, Y: 2, W: 5, H: 5}))
+	assert.Zero(t, a.Intersect(pi.IntArea{X: 10, Y: 10, W: 1, H: 1}).Size())
+}
+
+func TestArea_Overlaps(t *testing.T) {
+	a := pi.IntArea{X: 1, Y: 1, W: 4, H: 4}
+	assert.True(t, a.Overlaps(pi.IntArea{X: 4, Y: 4, W: 1, H: 1}))
+	assert.False(t, a.Overlaps(pi.IntArea{X: 5, Y: 1, W: 1, H: 1}), "touching")
+	assert.False(t, a.Overlaps(pi.IntArea{X: 2, Y: 2}), "empty")
+}
+
+func TestArea_Union(t *testing.T) {
+	a := pi.IntArea{X: 1, Y: 1, W: 2, H: 2}
+	assert.Equal(t, pi.IntArea{X: 1, Y: -1, W: 5, H: 4}, a.Union(pi.IntArea{X: 5, Y: -1, W: 1, H: 1}))
+	assert.Equal(t, a, a.Union(pi.IntArea{X: 10, Y: 10}))
+	assert.Equal(t, a, pi.IntArea{}.Union(a))
+}
+
+func TestArea_Expand(t *testing.T) {
+	a := pi.IntArea{X: 1, Y: 1, W: 2, H: 2}
+	assert.Equal(t, pi.IntArea{X: 0, Y: 0, W: 4, H: 4}, a.Expand(1))
+}
+
+func TestArea_Inset(t *testing.T) {
+	a := pi.IntArea{X: 1, Y: 1, W: 4, H: 4}
+	assert.Equal(t, pi.IntArea{X: 2, Y: 2, W: 2, H: 2}, a.Inset(1))
+	assert.Equal(t, pi.IntArea{X: 4, Y: 4, W: 0, H: 0}, a.Inset(3))
+}
+
+func TestArea_Center(t *testing.T) {
+	x, y := pi.IntArea{X: 1, Y: 2, W: 5, H: 4}.Center()
+	assert.Equal(t, 3, x)
+	assert.Equal(t, 4, y)
+
+	fx, fy := pi.Area[float64]{X: 1, Y: 2, W: 5, H: 4}.Center()
+	assert.Equal(t, 3.5, fx)
+	assert.Equal(t, 4.0, fy)
+}
+
+func TestArea_Corners(t *testing.T) {
+	x0, y0, x1, y1 := pi.IntArea{X: 1, Y: 2, W: 5, H: 4}.Corners()
+	assert.Equal(t, []int{1, 2, 6, 6}, []int{x0, y0, x1, y1})
+}
+
+func TestArea_Sweep(t *testing.T) {
+	wall := pi.Area[float64]{X: 10, Y: 0, W: 5, H: 5}
+
+	tests := map[string]struct {
+		area         pi.Area[float64]
+		dx, dy       float64
+		expectedHit  bool
+		expectedTime float64
+	}{
+		"moving right into wall": {
+			area: pi.Area[float64]{X: 0, Y: 0, W: 2, H: 2}, dx: 16, expectedHit: true, expectedTime: 0.5,
+		},
+		"moving left into wall": {
+			area: pi.Area[float64]{X: 20, Y: 1, W: 2, H: 2}, dx: -10, expectedHit: true, expectedTime: 0.5,
+		},
+		"moving diagonally into wall": {
+			area: pi.Area[float64]{X: 4, Y: -6, W: 2, H: 2}, dx: 8, dy: 8, expectedHit: true, expectedTime: 0.5,
+		},
+		"too short movement": {
+			area: pi.Area[float64]{X: 0, Y: 0, W: 2, H: 2}, dx: 7,
+		},
+		"touching at the end": {
+			area: pi.Area[float64]{X: 0, Y: 0, W: 2, H: 2}, dx: 8,
+		},
+		"passing below": {
+			area: pi.Area[float64]{X: 0, Y: 5, W: 2, H: 2}, dx: 20,
+		},
+		"moving away": {
+			area: pi.Area[float64]{X: 0, Y: 0, W: 2, H: 2}, dx: -20,
+		},
+		"already overlapping": {
+			area: pi.Area[float64]{X: 11, Y: 1, W: 2, H: 2}, dx: 1, expectedHit: true, expectedTime: 0,
+		},
+		"not moving": {
+			area: pi.Area[float64]{X: 0, Y: 0, W: 2, H: 2},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			toi, hit := test.area.Sweep(wall, test.dx, test.dy)
+			assert.Equal(t, test.expectedHit, hit)
+			assert.Equal(t, test.expectedTime, toi)
+		})
+	}
+
+	t.Run("should work for integer areas", func(t *testing.T) {
+		toi, hit := pi.IntArea{W: 1, H: 1}.Sweep(pi.IntArea{X: 3, W: 1, H: 1}, 4, 0)
+		assert.True(t, hit)
+		assert.Equal(t, 0.5, toi)
+	})
+}
+
+func TestConvertArea(t *testing.T) {
+	f := pi.ConvertArea[float64](pi.IntArea{X: 1, Y: -2, W: 3, H: 4})
+	assert.Equal(t, pi.Area[float64]{X: 1, Y: -2, W: 3, H: 4}, f)
+
+	i := pi.ConvertArea[int](pi.Area[float64]{X: 1.7, Y: -2.5, W: 3.2, H: 4.9})
+	assert.Equal(t, pi.IntArea{X: 1, Y: -2, W: 3, H: 4}, i)
+}
+
+func TestArea_Fixed(t *testing.T) {
+	one := pimath.FixedOne
+	a := pi.Area[pimath.Fixed]{X: one / 2, Y: 0, W: one, H: one}
+	assert.True(t, a.Contains(one, one/2))
+	assert.True(t, a.Overlaps(pi.Area[pimath.Fixed]{X: one, Y: one / 2, W: one, H: one}))
+}
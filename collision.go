@@ -0,0 +1,96 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pi
+
+// SpritesCollide reports whether sprite a drawn at (ax, ay) and sprite b
+// drawn at (bx, by) overlap on non-transparent pixels. Sprite flip flags
+// are taken into account.
+//
+// A color is transparent when drawing it with the current ColorTables
+// does not change any target color (for example, color 0 by default).
+// Colors are read using ReadMask.
+//
+// It also returns the overlap area - the smallest area containing
+// all colliding pixels, in the same coordinates as the sprite positions.
+func SpritesCollide(a Sprite, ax, ay int, b Sprite, bx, by int) (overlap IntArea, collide bool) {
+	var transparency [256]uint8 // 0 - not checked yet, 1 - transparent, 2 - opaque
+	isTransparent := func(c Color) bool {
+		c &= ReadMask
+		if transparency[c] == 0 {
+			transparency[c] = 1
+			table := &ColorTables[c>>6][c&(MaxColors-1)]
+			for target, result := range table {
+				if result != Color(target) {
+					transparency[c] = 2
+					break
+				}
+			}
+		}
+		return transparency[c] == 1
+	}
+	return spritesCollide(a, ax, ay, b, bx, by, isTransparent)
+}
+
+// SpritesCollideTransparent is like SpritesCollide, but the transparent
+// colors are given explicitly instead of being decided by ColorTables.
+// Colors are read using ReadMask before they are compared with transparent colors.
+func SpritesCollideTransparent(
+	a Sprite, ax, ay int, b Sprite, bx, by int, transparent ...Color,
+) (overlap IntArea, collide bool) {
+	var set [256]bool
+	for _, c := range transparent {
+		set[c] = true
+	}
+	isTransparent := func(c Color) bool {
+		return set[c&ReadMask]
+	}
+	return spritesCollide(a, ax, ay, b, bx, by, isTransparent)
+}
+
+func spritesCollide(a Sprite, ax, ay int, b Sprite, bx, by int, isTransparent func(Color) bool) (IntArea, bool) {
+	areaA := IntArea{X: ax, Y: ay, W: a.W, H: a.H}
+	areaB := IntArea{X: bx, Y: by, W: b.W, H: b.H}
+	common, _, _ := areaA.ClippedBy(areaB)
+
+	minX, minY := common.X+common.W, common.Y+common.H
+	maxX, maxY := common.X-1, common.Y-1
+
+	for y := common.Y; y < common.Y+common.H; y++ {
+		for x := common.X; x < common.X+common.W; x++ {
+			ca, ok := spritePixel(a, x-ax, y-ay)
+			if !ok || isTransparent(ca) {
+				continue
+			}
+			cb, ok := spritePixel(b, x-bx, y-by)
+			if !ok || isTransparent(cb) {
+				continue
+			}
+			minX, maxX = min(minX, x), max(maxX, x)
+			minY, maxY = min(minY, y), max(maxY, y)
+		}
+	}
+
+	if maxX < minX {
+		return IntArea{}, false
+	}
+	return IntArea{X: minX, Y: minY, W: maxX - minX + 1, H: maxY - minY + 1}, true
+}
+
+// spritePixel returns the color of the sprite pixel at (x, y) relative
+// to the sprite's top-left corner, as it would be drawn. It returns false
+// when the pixel is outside the sprite source.
+func spritePixel(s Sprite, x, y int) (Color, bool) {
+	if s.FlipX {
+		x = s.W - 1 - x
+	}
+	if s.FlipY {
+		y = s.H - 1 - y
+	}
+	x += s.X
+	y += s.Y
+	if x < 0 || y < 0 || x >= s.Source.width || y >= s.Source.height {
+		return 0, false
+	}
+	return s.Source.data[y*s.Source.width+x], true
+}
@@ -0,0 +1,106 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pi_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elgopher/pi"
+)
+
+// newCollisionSprite creates a 3x3 sprite:
+//
+//	0 0 0
+//	0 0 0
+//	0 0 c
+func newCollisionSprite(c pi.Color) pi.Sprite {
+	canvas := pi.NewCanvas(3, 3)
+	canvas.Set(2, 2, c)
+	return pi.CanvasSprite(canvas)
+}
+
+func TestSpritesCollide(t *testing.T) {
+	pi.ResetColorTables()
+	a := newCollisionSprite(7)
+	b := newCollisionSprite(8)
+
+	t.Run("should not collide when only bounding boxes overlap", func(t *testing.T) {
+		_, collide := pi.SpritesCollide(a, 0, 0, b, 1, 1)
+		assert.False(t, collide)
+	})
+
+	t.Run("should collide when opaque pixels overlap", func(t *testing.T) {
+		overlap, collide := pi.SpritesCollide(a, 10, 20, b, 10, 20)
+		assert.True(t, collide)
+		assert.Equal(t, pi.IntArea{X: 12, Y: 22, W: 1, H: 1}, overlap)
+	})
+
+	t.Run("should take flip flags into account", func(t *testing.T) {
+		flipped := b.WithFlipX(true).WithFlipY(true)
+		overlap, collide := pi.SpritesCollide(a, 0, 0, flipped, 2, 2)
+		assert.True(t, collide)
+		assert.Equal(t, pi.IntArea{X: 2, Y: 2, W: 1, H: 1}, overlap)
+	})
+
+	t.Run("should not collide when sprites are far away", func(t *testing.T) {
+		_, collide := pi.SpritesCollide(a, 0, 0, b, 5, 5)
+		assert.False(t, collide)
+	})
+
+	t.Run("should return area containing all colliding pixels", func(t *testing.T) {
+		canvas := pi.NewCanvas(4, 2)
+		canvas.SetAll(
+			1, 0, 0, 1,
+			0, 1, 1, 0,
+		)
+		full := pi.NewCanvas(4, 2)
+		full.Clear(2)
+		overlap, collide := pi.SpritesCollide(pi.CanvasSprite(canvas), 0, 0, pi.CanvasSprite(full), 1, 0)
+		assert.True(t, collide)
+		assert.Equal(t, pi.IntArea{X: 1, Y: 0, W: 3, H: 2}, overlap)
+	})
+
+	t.Run("should use color tables to decide transparency", func(t *testing.T) {
+		defer pi.ResetColorTables()
+		pi.ColorTables[0][7] = pi.ColorTables[1][7] // identity - drawing color 7 changes nothing
+		_, collide := pi.SpritesCollide(a, 0, 0, b, 0, 0)
+		assert.False(t, collide)
+	})
+}
+
+func TestSpritesCollideTransparent(t *testing.T) {
+	a := newCollisionSprite(7)
+	b := newCollisionSprite(8)
+
+	t.Run("should collide when opaque pixels overlap", func(t *testing.T) {
+		_, collide := pi.SpritesCollideTransparent(a, 0, 0, b, 0, 0, 0)
+		assert.True(t, collide)
+	})
+
+	t.Run("should treat given colors as transparent", func(t *testing.T) {
+		_, collide := pi.SpritesCollideTransparent(a, 0, 0, b, 0, 0, 0, 8)
+		assert.False(t, collide)
+	})
+
+	t.Run("should collide on any pixel when there are no transparent colors", func(t *testing.T) {
+		overlap, collide := pi.SpritesCollideTransparent(a, 0, 0, b, 2, 1)
+		assert.True(t, collide)
+		assert.Equal(t, pi.IntArea{X: 2, Y: 1, W: 1, H: 2}, overlap)
+	})
+
+	t.Run("should read colors using ReadMask", func(t *testing.T) {
+		prevReadMask := pi.ReadMask
+		defer func() {
+			pi.ReadMask = prevReadMask
+		}()
+		pi.ReadMask = 0b00111111
+		withTableBits := newCollisionSprite(8 | 0b01000000)
+		// when
+		_, collide := pi.SpritesCollideTransparent(a, 0, 0, withTableBits, 0, 0, 0, 8)
+		// then
+		assert.False(t, collide)
+	})
+}
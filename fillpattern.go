@@ -0,0 +1,61 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pi
+
+// FillPattern is an 8x8 bit pattern used by shape drawing functions
+// and SetPixel. It can be used for dithered gradients, shadows, checkerboards, etc.
+//
+// Pixels for which the pattern bit is 0 are drawn using the draw color.
+// Pixels for which the pattern bit is 1 are drawn using the secondary Color,
+// or not drawn at all when Transparent is true.
+//
+// The pattern is aligned to the draw target, not to the shape,
+// so shapes drawn next to each other share the same pattern.
+//
+// The zero value is a pattern with all bits set to 0,
+// which means that the draw color is used for all pixels.
+type FillPattern struct {
+	// Bits holds 8 rows of the pattern. The most significant bit
+	// of each row is the leftmost pixel.
+	Bits        [8]uint8
+	Color       Color // secondary color
+	Transparent bool  // when true, the secondary color is not drawn
+}
+
+// Pattern4x4 converts a 4x4 pattern in Pico-8 format to 8x8 pattern bits.
+//
+// The most significant bit is the top-left pixel. For example,
+// 0b1010_0101_1010_0101 is a checkerboard.
+func Pattern4x4(bits uint16) (rows [8]uint8) {
+	for y := 0; y < 4; y++ {
+		row := uint8(bits>>(12-4*y)) & 0xF
+		rows[y] = row<<4 | row
+		rows[y+4] = rows[y]
+	}
+	return
+}
+
+var fillPattern FillPattern
+
+// SetFillPattern sets the fill pattern used by all subsequent shape drawing
+// functions (like Line, Rect, RectFill, Circ, CircFill) and SetPixel.
+//
+// To disable the pattern, pass the zero FillPattern.
+//
+// Returns the previous pattern.
+func SetFillPattern(p FillPattern) (prev FillPattern) {
+	prev = fillPattern
+	fillPattern = p
+	return
+}
+
+// GetFillPattern returns the current fill pattern.
+func GetFillPattern() FillPattern {
+	return fillPattern
+}
+
+// patternBit reports whether the pattern bit for draw target coordinates (x, y) is 1.
+func patternBit(x, y int) bool {
+	return fillPattern.Bits[y&7]&(0x80>>(x&7)) != 0
+}
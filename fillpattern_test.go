@@ -0,0 +1,114 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pi_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elgopher/pi"
+	"github.com/elgopher/pi/pitest"
+)
+
+func TestPattern4x4(t *testing.T) {
+	rows := pi.Pattern4x4(0b1000_0100_0010_0001)
+	expected := [8]uint8{
+		0b10001000,
+		0b01000100,
+		0b00100010,
+		0b00010001,
+		0b10001000,
+		0b01000100,
+		0b00100010,
+		0b00010001,
+	}
+	assert.Equal(t, expected, rows)
+}
+
+func TestSetFillPattern(t *testing.T) {
+	checkerboard := pi.Pattern4x4(0b1010_0101_1010_0101)
+
+	tests := map[string]func(){
+		"RectFill": func() { pi.RectFill(0, 0, 3, 3) },
+		"SetPixel": func() {
+			for y := 0; y < 4; y++ {
+				for x := 0; x < 4; x++ {
+					pi.SetPixel(x, y)
+				}
+			}
+		},
+		"Line": func() {
+			for y := 0; y < 4; y++ {
+				pi.Line(0, y, 3, y)
+			}
+		},
+	}
+
+	for testName, draw := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Run("should use secondary color", func(t *testing.T) {
+				pi.ResetColorTables()
+				pi.SetDrawTarget(pi.NewCanvas(4, 4))
+				pi.SetColor(1)
+				prev := pi.SetFillPattern(pi.FillPattern{Bits: checkerboard, Color: 2})
+				defer pi.SetFillPattern(prev)
+				// when
+				draw()
+				// then
+				expected := pi.NewCanvas(4, 4)
+				expected.SetAll(
+					2, 1, 2, 1,
+					1, 2, 1, 2,
+					2, 1, 2, 1,
+					1, 2, 1, 2,
+				)
+				pitest.AssertSurfaceEqual(t, expected, pi.DrawTarget())
+			})
+
+			t.Run("should skip pixels when transparent", func(t *testing.T) {
+				pi.ResetColorTables()
+				pi.SetDrawTarget(pi.NewCanvas(4, 4))
+				pi.DrawTarget().Clear(3)
+				pi.SetColor(1)
+				prev := pi.SetFillPattern(pi.FillPattern{Bits: checkerboard, Transparent: true})
+				defer pi.SetFillPattern(prev)
+				// when
+				draw()
+				// then
+				expected := pi.NewCanvas(4, 4)
+				expected.SetAll(
+					3, 1, 3, 1,
+					1, 3, 1, 3,
+					3, 1, 3, 1,
+					1, 3, 1, 3,
+				)
+				pitest.AssertSurfaceEqual(t, expected, pi.DrawTarget())
+			})
+
+			t.Run("should align pattern to draw target", func(t *testing.T) {
+				pi.ResetColorTables()
+				pi.SetDrawTarget(pi.NewCanvas(4, 4))
+				pi.SetColor(1)
+				pi.Camera = pi.Position{X: -1}
+				defer func() {
+					pi.Camera = pi.Position{}
+				}()
+				prev := pi.SetFillPattern(pi.FillPattern{Bits: checkerboard, Color: 2})
+				defer pi.SetFillPattern(prev)
+				// when
+				draw()
+				// then
+				expected := pi.NewCanvas(4, 4)
+				expected.SetAll(
+					0, 1, 2, 1,
+					0, 2, 1, 2,
+					0, 1, 2, 1,
+					0, 2, 1, 2,
+				)
+				pitest.AssertSurfaceEqual(t, expected, pi.DrawTarget())
+			})
+		})
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pi
+
+// FloodFill replaces all values in the region connected to (x, y)
+// with the given value. The region consists of values equal to the value
+// at (x, y), connected horizontally or vertically (4-connectivity).
+//
+// It returns the number of replaced values. If (x, y) is outside the surface
+// or the value at (x, y) is already equal to the given value, nothing is replaced.
+//
+// FloodFill uses the scanline algorithm, so it does not use recursion
+// and can be safely used on big surfaces. It does not use global state,
+// so it can be called concurrently for different surfaces.
+func FloodFill[T comparable](s Surface[T], x, y int, value T) (filled int) {
+	if !s.EntireArea().Contains(x, y) {
+		return 0
+	}
+
+	old := s.data[y*s.width+x]
+	if old == value {
+		return 0
+	}
+
+	inside := func(x, y int) bool {
+		return s.data[y*s.width+x] == old
+	}
+	fill := func(x0, x1, y int) {
+		line := s.data[y*s.width+x0 : y*s.width+x1+1]
+		for i := range line {
+			line[i] = value
+		}
+		filled += x1 - x0 + 1
+	}
+	scanlineFill(nil, x, y, s.EntireArea(), inside, fill)
+
+	return filled
+}
+
+// Flood fills the region connected to (x, y) using the draw color,
+// like a paint bucket tool. The region consists of pixels with the same
+// color as the pixel at (x, y), connected horizontally or vertically.
+//
+// The region is limited to the clipping region.
+//
+// It takes into account the camera position, clipping region,
+// color tables, masks, and fill pattern.
+func Flood(x, y int) {
+	x -= Camera.X
+	y -= Camera.Y
+
+	if !clip.Contains(x, y) {
+		return
+	}
+
+	if len(floodVisited) < len(drawTarget.data) {
+		floodVisited = make([]bool, len(drawTarget.data))
+	}
+
+	width := drawTarget.width
+	old := drawTarget.data[y*width+x]
+
+	floodSpans = floodSpans[:0]
+
+	// find the region first, because drawing could produce the same color,
+	// which would make it impossible to tell which pixels were already drawn:
+	inside := func(x, y int) bool {
+		idx := y*width + x
+		return !floodVisited[idx] && drawTarget.data[idx] == old
+	}
+	fill := func(x0, x1, y int) {
+		visited := floodVisited[y*width+x0 : y*width+x1+1]
+		for i := range visited {
+			visited[i] = true
+		}
+		floodSpans = append(floodSpans, floodSpan{x0: x0, x1: x1, y: y})
+	}
+	floodStack = scanlineFill(floodStack, x, y, clip, inside, fill)
+
+	draw := drawColor & ReadMask
+	for _, s := range floodSpans {
+		for px := s.x0; px <= s.x1; px++ {
+			setPixelWithColor(px+Camera.X, s.y+Camera.Y, draw)
+		}
+		visited := floodVisited[s.y*width+s.x0 : s.y*width+s.x1+1]
+		for i := range visited {
+			visited[i] = false
+		}
+	}
+}
+
+// buffers reused between calls to Flood to avoid allocations
+var (
+	floodVisited []bool
+	floodSpans   []floodSpan
+	floodStack   []Position
+)
+
+type floodSpan struct{ x0, x1, y int }
+
+// scanlineFill finds the 4-connected region starting at (x, y) inside the area.
+//
+// inside reports whether the pixel belongs to the region and has not been filled yet.
+// fill is called for each horizontal span of the region and must make
+// inside return false for all pixels in the span.
+//
+// stack is used as a buffer for pixels to visit. It is returned,
+// so it can be reused in the next call.
+func scanlineFill(
+	stack []Position, x, y int, area IntArea, inside func(x, y int) bool, fill func(x0, x1, y int),
+) []Position {
+	minX, maxX := area.X, area.X+area.W-1
+	minY, maxY := area.Y, area.Y+area.H-1
+
+	stack = append(stack[:0], Position{X: x, Y: y})
+
+	for len(stack) > 0 {
+		p := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if !inside(p.X, p.Y) {
+			continue
+		}
+
+		x0 := p.X
+		for x0 > minX && inside(x0-1, p.Y) {
+			x0--
+		}
+		x1 := p.X
+		for x1 < maxX && inside(x1+1, p.Y) {
+			x1++
+		}
+
+		fill(x0, x1, p.Y)
+
+		if p.Y > minY {
+			stack = pushSpanSeeds(stack, x0, x1, p.Y-1, inside)
+		}
+		if p.Y < maxY {
+			stack = pushSpanSeeds(stack, x0, x1, p.Y+1, inside)
+		}
+	}
+
+	return stack
+}
+
+// pushSpanSeeds pushes the first pixel of each run of inside pixels between x0 and x1.
+func pushSpanSeeds(stack []Position, x0, x1, y int, inside func(x, y int) bool) []Position {
+	inRun := false
+	for x := x0; x <= x1; x++ {
+		if inside(x, y) {
+			if !inRun {
+				stack = append(stack, Position{X: x, Y: y})
+				inRun = true
+			}
+		} else {
+			inRun = false
+		}
+	}
+
+	return stack
+}
@@ -0,0 +1,126 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pi_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elgopher/pi"
+	"github.com/elgopher/pi/pitest"
+)
+
+func TestFloodFill(t *testing.T) {
+	t.Run("should fill connected region", func(t *testing.T) {
+		surface := pi.NewSurface[rune](6, 4)
+		surface.SetAll(
+			'.', '.', '#', '.', '.', '.',
+			'#', '.', '#', '.', '#', '.',
+			'.', '.', '.', '.', '#', '.',
+			'#', '#', '#', '#', '.', '#',
+		)
+		// when
+		filled := pi.FloodFill(surface, 1, 1, 'o')
+		// then
+		expected := pi.NewSurface[rune](6, 4)
+		expected.SetAll(
+			'o', 'o', '#', 'o', 'o', 'o',
+			'#', 'o', '#', 'o', '#', 'o',
+			'o', 'o', 'o', 'o', '#', 'o',
+			'#', '#', '#', '#', '.', '#',
+		)
+		pitest.AssertSurfaceEqual(t, expected, surface)
+		assert.Equal(t, 13, filled)
+	})
+
+	t.Run("should not fill when value is the same", func(t *testing.T) {
+		surface := pi.NewSurface[int](2, 2)
+		filled := pi.FloodFill(surface, 0, 0, 0)
+		assert.Zero(t, filled)
+	})
+
+	t.Run("should not fill when outside the surface", func(t *testing.T) {
+		surface := pi.NewSurface[int](2, 2)
+		filled := pi.FloodFill(surface, 2, 0, 1)
+		assert.Zero(t, filled)
+		pitest.AssertSurfaceEqual(t, pi.NewSurface[int](2, 2), surface)
+	})
+
+	t.Run("should fill big surface", func(t *testing.T) {
+		surface := pi.NewSurface[bool](320, 180)
+		filled := pi.FloodFill(surface, 160, 90, true)
+		assert.Equal(t, 320*180, filled)
+	})
+
+	t.Run("should fill different surfaces concurrently", func(t *testing.T) {
+		var wg sync.WaitGroup
+		filled := make([]int, 4)
+		for i := range filled {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				surface := pi.NewSurface[int](64, 64)
+				filled[i] = pi.FloodFill(surface, 0, 0, 1)
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, []int{4096, 4096, 4096, 4096}, filled)
+	})
+}
+
+func TestFlood(t *testing.T) {
+	t.Run("should fill region limited by clip and camera", func(t *testing.T) {
+		pi.ResetColorTables()
+		pi.SetDrawTarget(pi.NewCanvas(5, 3))
+		pi.DrawTarget().SetAll(
+			0, 0, 1, 0, 0,
+			0, 0, 1, 0, 0,
+			0, 0, 0, 0, 0,
+		)
+		pi.SetClip(pi.IntArea{W: 4, H: 3})
+		pi.Camera = pi.Position{X: 1, Y: 1}
+		defer func() {
+			pi.Camera = pi.Position{}
+		}()
+		pi.SetColor(2)
+		// when
+		pi.Flood(1, 1)
+		// then
+		expected := pi.NewCanvas(5, 3)
+		expected.SetAll(
+			2, 2, 1, 2, 0,
+			2, 2, 1, 2, 0,
+			2, 2, 2, 2, 0,
+		)
+		pitest.AssertSurfaceEqual(t, expected, pi.DrawTarget())
+	})
+
+	t.Run("should draw each pixel once using color tables", func(t *testing.T) {
+		pi.ResetColorTables()
+		defer pi.ResetColorTables()
+		// drawing color 1 increments the target color
+		for target := pi.Color(0); target < pi.MaxColors-1; target++ {
+			pi.ColorTables[0][1][target] = target + 1
+		}
+		pi.SetDrawTarget(pi.NewCanvas(3, 3))
+		pi.DrawTarget().SetAll(
+			0, 0, 0,
+			0, 1, 0,
+			0, 0, 0,
+		)
+		pi.SetColor(1)
+		// when
+		pi.Flood(0, 0)
+		// then
+		expected := pi.NewCanvas(3, 3)
+		expected.SetAll(
+			1, 1, 1,
+			1, 1, 1,
+			1, 1, 1,
+		)
+		pitest.AssertSurfaceEqual(t, expected, pi.DrawTarget())
+	})
+}
@@ -0,0 +1,247 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+// Package aseprite reads and writes files in the Aseprite format.
+//
+// Format specification:
+// https://github.com/aseprite/aseprite/blob/main/docs/ase-file-specs.md
+package aseprite
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+)
+
+const (
+	headerMagic = 0xA5E0
+	frameMagic  = 0xF1FA
+
+	headerSize      = 128
+	frameHeaderSize = 16
+	chunkHeaderSize = 6
+)
+
+// Chunk types
+const (
+	ChunkOldPalette = 0x0004
+	ChunkLayer      = 0x2004
+	ChunkCel        = 0x2005
+	ChunkTags       = 0x2018
+	ChunkPalette    = 0x2019
+	ChunkSlice      = 0x2022
+)
+
+// Color depths
+const (
+	DepthIndexed   = 8
+	DepthGrayscale = 16
+	DepthRGBA      = 32
+)
+
+type File struct {
+	Frames           int
+	Width, Height    int
+	ColorDepth       int
+	TransparentIndex uint8
+	FrameList        []Frame
+}
+
+type Frame struct {
+	Duration int // in milliseconds
+	Chunks   []Chunk
+}
+
+type Chunk struct {
+	Type uint16
+	Data []byte
+}
+
+// maxPaletteSize is the maximum number of colors in the palette of indexed image.
+const maxPaletteSize = 256
+
+var errUnexpectedEOF = errors.New("unexpected end of file")
+
+// Decode decodes the file header and splits frames into raw chunks.
+func Decode(data []byte) (File, error) {
+	r := reader{data: data}
+
+	r.dword() // file size
+	if magic := r.word(); magic != headerMagic && r.err == nil {
+		return File{}, fmt.Errorf("invalid magic number 0x%X", magic)
+	}
+
+	f := File{
+		Frames:     int(r.word()),
+		Width:      int(r.word()),
+		Height:     int(r.word()),
+		ColorDepth: int(r.word()),
+	}
+	r.skip(4 + 2 + 4 + 4) // flags, speed and two reserved dwords
+	f.TransparentIndex = r.byte()
+	r.skip(headerSize - r.offset)
+	if r.err != nil {
+		return File{}, r.err
+	}
+
+	for i := 0; i < f.Frames; i++ {
+		frameStart := r.offset
+		frameSize := int(r.dword())
+		if magic := r.word(); magic != frameMagic && r.err == nil {
+			return File{}, fmt.Errorf("invalid frame %d magic number 0x%X", i, magic)
+		}
+		chunks := int(r.word())
+		frame := Frame{Duration: int(r.word())}
+		r.skip(2)
+		if newChunks := int(r.dword()); newChunks != 0 {
+			chunks = newChunks
+		}
+
+		for c := 0; c < chunks && r.err == nil; c++ {
+			chunkSize := int(r.dword())
+			chunkType := r.word()
+			chunkData := r.bytes(chunkSize - chunkHeaderSize)
+			frame.Chunks = append(frame.Chunks, Chunk{Type: chunkType, Data: chunkData})
+		}
+
+		if r.err != nil {
+			return File{}, fmt.Errorf("decoding frame %d failed: %w", i, r.err)
+		}
+
+		r.offset = frameStart + frameSize
+		f.FrameList = append(f.FrameList, frame)
+	}
+
+	return f, nil
+}
+
+// Palette returns colors from palette chunks. Old palette chunks
+// are used only when there are no new palette chunks.
+func (f File) Palette() ([]color.NRGBA, error) {
+	var palette []color.NRGBA
+	newPaletteFound := false
+
+	for _, frame := range f.FrameList {
+		for _, chunk := range frame.Chunks {
+			if chunk.Type != ChunkPalette {
+				continue
+			}
+			newPaletteFound = true
+
+			r := reader{data: chunk.Data}
+			size := int(r.dword())
+			first := int(r.dword())
+			last := int(r.dword())
+			r.skip(8)
+			if size > maxPaletteSize {
+				return nil, fmt.Errorf("palette has too many colors: %d", size)
+			}
+			if size > len(palette) {
+				palette = append(palette, make([]color.NRGBA, size-len(palette))...)
+			}
+			for i := first; i <= last && r.err == nil; i++ {
+				flags := r.word()
+				c := color.NRGBA{R: r.byte(), G: r.byte(), B: r.byte(), A: r.byte()}
+				if flags&1 != 0 {
+					r.string() // name
+				}
+				if i < len(palette) {
+					palette[i] = c
+				}
+			}
+			if r.err != nil {
+				return nil, fmt.Errorf("decoding palette chunk failed: %w", r.err)
+			}
+		}
+	}
+
+	if newPaletteFound {
+		return palette, nil
+	}
+
+	for _, frame := range f.FrameList {
+		for _, chunk := range frame.Chunks {
+			if chunk.Type != ChunkOldPalette {
+				continue
+			}
+
+			r := reader{data: chunk.Data}
+			packets := int(r.word())
+			index := 0
+			for p := 0; p < packets && r.err == nil; p++ {
+				index += int(r.byte())
+				colors := int(r.byte())
+				if colors == 0 {
+					colors = 256
+				}
+				if index+colors > maxPaletteSize {
+					return nil, fmt.Errorf("palette has too many colors: %d", index+colors)
+				}
+				if index+colors > len(palette) {
+					palette = append(palette, make([]color.NRGBA, index+colors-len(palette))...)
+				}
+				for i := 0; i < colors; i++ {
+					palette[index] = color.NRGBA{R: r.byte(), G: r.byte(), B: r.byte(), A: 255}
+					index++
+				}
+			}
+			if r.err != nil {
+				return nil, fmt.Errorf("decoding old palette chunk failed: %w", r.err)
+			}
+		}
+	}
+
+	return palette, nil
+}
+
+type reader struct {
+	data   []byte
+	offset int
+	err    error
+}
+
+func (r *reader) bytes(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n < 0 || r.offset+n > len(r.data) {
+		r.err = errUnexpectedEOF
+		return nil
+	}
+	b := r.data[r.offset : r.offset+n]
+	r.offset += n
+	return b
+}
+
+func (r *reader) skip(n int) {
+	r.bytes(n)
+}
+
+func (r *reader) byte() byte {
+	b := r.bytes(1)
+	if b == nil {
+		return 0
+	}
+	return b[0]
+}
+
+func (r *reader) word() uint16 {
+	b := r.bytes(2)
+	if b == nil {
+		return 0
+	}
+	return uint16(b[0]) | uint16(b[1])<<8
+}
+
+func (r *reader) dword() uint32 {
+	b := r.bytes(4)
+	if b == nil {
+		return 0
+	}
+	return uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16 | uint32(b[3])<<24
+}
+
+func (r *reader) string() string {
+	n := int(r.word())
+	return string(r.bytes(n))
+}
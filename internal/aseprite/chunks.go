@@ -0,0 +1,207 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package aseprite
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+)
+
+// Layer types
+const (
+	LayerNormal  = 0
+	LayerGroup   = 1
+	LayerTilemap = 2
+)
+
+// Cel types
+const (
+	CelRaw        = 0
+	CelLinked     = 1
+	CelCompressed = 2
+	CelTilemap    = 3
+)
+
+type Layer struct {
+	Visible    bool
+	Background bool // background layer has no transparent pixels
+	Type       int
+	ChildLevel int
+	Opacity    uint8
+	Name       string
+}
+
+func ParseLayer(data []byte) (Layer, error) {
+	r := reader{data: data}
+	flags := r.word()
+	l := Layer{
+		Visible:    flags&1 != 0,
+		Background: flags&8 != 0,
+		Type:       int(r.word()),
+		ChildLevel: int(r.word()),
+	}
+	r.skip(2 + 2 + 2) // default width, height and blend mode
+	l.Opacity = r.byte()
+	r.skip(3)
+	l.Name = r.string()
+	if r.err != nil {
+		return Layer{}, fmt.Errorf("decoding layer chunk failed: %w", r.err)
+	}
+	return l, nil
+}
+
+type Cel struct {
+	Layer         int
+	X, Y          int
+	Type          int
+	LinkedFrame   int    // only for CelLinked
+	Width, Height int    // only for CelRaw and CelCompressed
+	Pixels        []byte // only for CelRaw and CelCompressed, decompressed
+}
+
+// ParseCel parses the cel chunk. Cels bigger than the canvas are rejected,
+// so corrupted files cannot allocate huge amounts of memory.
+func ParseCel(data []byte, bytesPerPixel, canvasWidth, canvasHeight int) (Cel, error) {
+	r := reader{data: data}
+	c := Cel{
+		Layer: int(r.word()),
+		X:     int(int16(r.word())),
+		Y:     int(int16(r.word())),
+	}
+	r.skip(1) // opacity
+	c.Type = int(r.word())
+	r.skip(2 + 5) // z-index and reserved
+
+	switch c.Type {
+	case CelLinked:
+		c.LinkedFrame = int(r.word())
+	case CelRaw, CelCompressed:
+		c.Width = int(r.word())
+		c.Height = int(r.word())
+		if c.Width > canvasWidth || c.Height > canvasHeight {
+			return Cel{}, fmt.Errorf("cel size %dx%d is bigger than canvas %dx%d",
+				c.Width, c.Height, canvasWidth, canvasHeight)
+		}
+		size := c.Width * c.Height * bytesPerPixel
+		if c.Type == CelRaw {
+			c.Pixels = r.bytes(size)
+			break
+		}
+		rest := r.bytes(len(r.data) - r.offset)
+		if r.err != nil {
+			break
+		}
+		pixels, err := decompress(rest, size)
+		if err != nil {
+			return Cel{}, fmt.Errorf("decompressing cel failed: %w", err)
+		}
+		c.Pixels = pixels
+	}
+
+	if r.err != nil {
+		return Cel{}, fmt.Errorf("decoding cel chunk failed: %w", r.err)
+	}
+	return c, nil
+}
+
+func decompress(data []byte, size int) ([]byte, error) {
+	zr, err := zlib.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+	pixels := make([]byte, size)
+	if _, err = io.ReadFull(zr, pixels); err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+	return pixels, nil
+}
+
+// Tag directions
+const (
+	DirectionForward         = 0
+	DirectionReverse         = 1
+	DirectionPingPong        = 2
+	DirectionPingPongReverse = 3
+)
+
+type Tag struct {
+	From, To  int
+	Direction int
+	Repeat    int
+	Name      string
+}
+
+func ParseTags(data []byte) ([]Tag, error) {
+	r := reader{data: data}
+	n := int(r.word())
+	r.skip(8)
+	var tags []Tag
+	for i := 0; i < n && r.err == nil; i++ {
+		t := Tag{
+			From:      int(r.word()),
+			To:        int(r.word()),
+			Direction: int(r.byte()),
+			Repeat:    int(r.word()),
+		}
+		r.skip(6 + 3 + 1) // reserved, deprecated color and extra byte
+		t.Name = r.string()
+		tags = append(tags, t)
+	}
+	if r.err != nil {
+		return nil, fmt.Errorf("decoding tags chunk failed: %w", r.err)
+	}
+	return tags, nil
+}
+
+type Slice struct {
+	Name string
+	Keys []SliceKey
+}
+
+type SliceKey struct {
+	Frame            int
+	X, Y             int
+	Width, Height    int
+	HasCenter        bool
+	CenterX, CenterY int
+	CenterW, CenterH int
+	HasPivot         bool
+	PivotX, PivotY   int
+}
+
+func ParseSlice(data []byte) (Slice, error) {
+	r := reader{data: data}
+	n := int(r.dword())
+	flags := r.dword()
+	r.skip(4)
+	s := Slice{Name: r.string()}
+	for i := 0; i < n && r.err == nil; i++ {
+		k := SliceKey{
+			Frame:  int(r.dword()),
+			X:      int(int32(r.dword())),
+			Y:      int(int32(r.dword())),
+			Width:  int(r.dword()),
+			Height: int(r.dword()),
+		}
+		if flags&1 != 0 {
+			k.HasCenter = true
+			k.CenterX = int(int32(r.dword()))
+			k.CenterY = int(int32(r.dword()))
+			k.CenterW = int(r.dword())
+			k.CenterH = int(r.dword())
+		}
+		if flags&2 != 0 {
+			k.HasPivot = true
+			k.PivotX = int(int32(r.dword()))
+			k.PivotY = int(int32(r.dword()))
+		}
+		s.Keys = append(s.Keys, k)
+	}
+	if r.err != nil {
+		return Slice{}, fmt.Errorf("decoding slice chunk failed: %w", r.err)
+	}
+	return s, nil
+}
@@ -0,0 +1,227 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package aseprite
+
+import (
+	"bytes"
+	"compress/zlib"
+	"image/color"
+)
+
+// EncodePalette encodes a file with indexed color mode, containing only
+// a single empty frame with one layer and the palette.
+//
+// Such file can be loaded by Aseprite as a palette.
+func EncodePalette(palette []color.NRGBA) []byte {
+	return Encode(File{
+		Width:      1,
+		Height:     1,
+		ColorDepth: DepthIndexed,
+		FrameList: []Frame{
+			{
+				Duration: 100,
+				Chunks: []Chunk{
+					EncodeLayer(Layer{Visible: true, Opacity: 255, Name: "Layer 1"}),
+					EncodePaletteChunk(palette),
+				},
+			},
+		},
+	})
+}
+
+// Encode encodes the file. It is the opposite of Decode - chunks
+// of each frame are written as they are. File.Frames is ignored,
+// the number of frames is taken from File.FrameList.
+func Encode(f File) []byte {
+	var frames writer
+	for _, frame := range f.FrameList {
+		var chunks writer
+		for _, c := range frame.Chunks {
+			chunks.chunk(c.Type, c.Data)
+		}
+		frames.dword(uint32(frameHeaderSize + len(chunks.data)))
+		frames.word(frameMagic)
+		frames.word(uint16(min(len(frame.Chunks), 0xFFFF)))
+		frames.word(uint16(frame.Duration))
+		frames.zeros(2)
+		frames.dword(uint32(len(frame.Chunks)))
+		frames.data = append(frames.data, chunks.data...)
+	}
+
+	palette, _ := f.Palette()
+
+	var file writer
+	file.dword(uint32(headerSize + len(frames.data)))
+	file.word(headerMagic)
+	file.word(uint16(len(f.FrameList)))
+	file.word(uint16(f.Width))
+	file.word(uint16(f.Height))
+	file.word(uint16(f.ColorDepth))
+	file.dword(1) // flags: layer opacity has valid value
+	file.word(100)
+	file.zeros(8)
+	file.byte(f.TransparentIndex)
+	file.zeros(3)
+	file.word(uint16(len(palette)))
+	file.byte(1) // pixel width
+	file.byte(1) // pixel height
+	file.zeros(headerSize - len(file.data))
+	file.data = append(file.data, frames.data...)
+
+	return file.data
+}
+
+// EncodeLayer encodes the layer chunk. It is the opposite of ParseLayer.
+func EncodeLayer(l Layer) Chunk {
+	var w writer
+	var flags uint16
+	if l.Visible {
+		flags |= 1
+	}
+	if l.Background {
+		flags |= 8
+	}
+	w.word(flags)
+	w.word(uint16(l.Type))
+	w.word(uint16(l.ChildLevel))
+	w.word(0) // default width (ignored)
+	w.word(0) // default height (ignored)
+	w.word(0) // blend mode: normal
+	w.byte(l.Opacity)
+	w.zeros(3)
+	w.string(l.Name)
+	return Chunk{Type: ChunkLayer, Data: w.data}
+}
+
+// EncodeCel encodes the cel chunk. It is the opposite of ParseCel.
+// Pixels of CelCompressed cels are compressed.
+func EncodeCel(c Cel) Chunk {
+	var w writer
+	w.word(uint16(c.Layer))
+	w.word(uint16(c.X))
+	w.word(uint16(c.Y))
+	w.byte(255) // opacity
+	w.word(uint16(c.Type))
+	w.zeros(2 + 5) // z-index and reserved
+
+	switch c.Type {
+	case CelLinked:
+		w.word(uint16(c.LinkedFrame))
+	case CelRaw:
+		w.word(uint16(c.Width))
+		w.word(uint16(c.Height))
+		w.data = append(w.data, c.Pixels...)
+	case CelCompressed:
+		w.word(uint16(c.Width))
+		w.word(uint16(c.Height))
+		var compressed bytes.Buffer
+		zw := zlib.NewWriter(&compressed)
+		_, _ = zw.Write(c.Pixels)
+		_ = zw.Close()
+		w.data = append(w.data, compressed.Bytes()...)
+	}
+
+	return Chunk{Type: ChunkCel, Data: w.data}
+}
+
+// EncodeTags encodes the tags chunk. It is the opposite of ParseTags.
+func EncodeTags(tags []Tag) Chunk {
+	var w writer
+	w.word(uint16(len(tags)))
+	w.zeros(8)
+	for _, t := range tags {
+		w.word(uint16(t.From))
+		w.word(uint16(t.To))
+		w.byte(byte(t.Direction))
+		w.word(uint16(t.Repeat))
+		w.zeros(6 + 3 + 1) // reserved, deprecated color and extra byte
+		w.string(t.Name)
+	}
+	return Chunk{Type: ChunkTags, Data: w.data}
+}
+
+// EncodeSlice encodes the slice chunk. It is the opposite of ParseSlice.
+//
+// Center and pivot are written for all keys when the first key has them.
+func EncodeSlice(s Slice) Chunk {
+	var flags uint32
+	if len(s.Keys) > 0 && s.Keys[0].HasCenter {
+		flags |= 1
+	}
+	if len(s.Keys) > 0 && s.Keys[0].HasPivot {
+		flags |= 2
+	}
+
+	var w writer
+	w.dword(uint32(len(s.Keys)))
+	w.dword(flags)
+	w.zeros(4)
+	w.string(s.Name)
+	for _, k := range s.Keys {
+		w.dword(uint32(k.Frame))
+		w.dword(uint32(k.X))
+		w.dword(uint32(k.Y))
+		w.dword(uint32(k.Width))
+		w.dword(uint32(k.Height))
+		if flags&1 != 0 {
+			w.dword(uint32(k.CenterX))
+			w.dword(uint32(k.CenterY))
+			w.dword(uint32(k.CenterW))
+			w.dword(uint32(k.CenterH))
+		}
+		if flags&2 != 0 {
+			w.dword(uint32(k.PivotX))
+			w.dword(uint32(k.PivotY))
+		}
+	}
+	return Chunk{Type: ChunkSlice, Data: w.data}
+}
+
+// EncodePaletteChunk encodes the palette chunk with all colors of the palette.
+func EncodePaletteChunk(palette []color.NRGBA) Chunk {
+	var w writer
+	w.dword(uint32(len(palette)))
+	w.dword(0) // first index
+	w.dword(uint32(max(len(palette)-1, 0)))
+	w.zeros(8)
+	for _, c := range palette {
+		w.word(0) // flags: no name
+		w.byte(c.R)
+		w.byte(c.G)
+		w.byte(c.B)
+		w.byte(c.A)
+	}
+	return Chunk{Type: ChunkPalette, Data: w.data}
+}
+
+type writer struct {
+	data []byte
+}
+
+func (w *writer) byte(b byte) {
+	w.data = append(w.data, b)
+}
+
+func (w *writer) zeros(n int) {
+	w.data = append(w.data, make([]byte, n)...)
+}
+
+func (w *writer) word(v uint16) {
+	w.data = append(w.data, byte(v), byte(v>>8))
+}
+
+func (w *writer) dword(v uint32) {
+	w.data = append(w.data, byte(v), byte(v>>8), byte(v>>16), byte(v>>24))
+}
+
+func (w *writer) string(s string) {
+	w.word(uint16(len(s)))
+	w.data = append(w.data, s...)
+}
+
+func (w *writer) chunk(chunkType uint16, data []byte) {
+	w.dword(uint32(chunkHeaderSize + len(data)))
+	w.word(chunkType)
+	w.data = append(w.data, data...)
+}
@@ -6,6 +6,7 @@ package pi
 import (
 	"bytes"
 	"fmt"
+	"image"
 	"image/color"
 	"image/png"
 	"strings"
@@ -126,7 +127,7 @@ func defaultPalette() PaletteArray {
 }
 
 var errToManyColors = fmt.Errorf(
-	"PNG file has too many colors in indexed palette. "+
+	"palette has too many colors. "+
 		"The maximum number is %d", MaxColors)
 
 // DecodePalette extracts a palette from a PNG file.
@@ -174,6 +175,40 @@ func DecodePaletteOrErr(pngFile []byte) (PaletteArray, error) {
 	return palette.Palette(), nil
 }
 
+// EncodePalette encodes the palette into a PNG file with indexed color mode.
+//
+// The image has 8x8 pixels, one pixel for each color. Such file can be decoded
+// back with DecodePalette.
+func EncodePalette(palette PaletteArray) []byte {
+	pngFile, err := EncodePaletteOrErr(palette)
+	if err != nil {
+		panic("EncodePalette failed: " + err.Error())
+	}
+	return pngFile
+}
+
+// EncodePaletteOrErr works like EncodePalette but returns an error
+// if the PNG file could not be encoded.
+func EncodePaletteOrErr(palette PaletteArray) ([]byte, error) {
+	var indexedPalette color.Palette
+	for _, rgb := range palette {
+		r, g, b := rgb.RGB()
+		indexedPalette = append(indexedPalette, color.NRGBA{R: r, G: g, B: b, A: 255})
+	}
+
+	img := image.NewPaletted(image.Rect(0, 0, 8, 8), indexedPalette)
+	for i := range img.Pix {
+		img.Pix[i] = uint8(i)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("PNG encoding failed: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
 func convertIndexedPaletteToRGB(indexedPalette color.Palette) PaletteArray {
 	var p PaletteArray
 	for i, col := range indexedPalette {
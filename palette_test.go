@@ -74,6 +74,18 @@ func TestDecodePaletteOrErr(t *testing.T) {
 	})
 }
 
+func TestEncodePaletteOrErr(t *testing.T) {
+	palette := pi.PaletteArray{0x000000, 0x123456, 0xFFFFFF}
+	palette[63] = 0xABCDEF
+	// when
+	pngFile, err := pi.EncodePaletteOrErr(palette)
+	// then
+	require.NoError(t, err)
+	decoded, err := pi.DecodePaletteOrErr(pngFile)
+	require.NoError(t, err)
+	assert.Equal(t, palette, decoded)
+}
+
 func TestFromRGB(t *testing.T) {
 	// when
 	rgb := pi.FromRGB(1, 128, 255)
@@ -0,0 +1,286 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pi
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"image/color"
+	"strconv"
+	"strings"
+
+	"github.com/elgopher/pi/internal/aseprite"
+)
+
+// DecodePaletteHex decodes a palette from a Lospec .hex file.
+//
+// Each line of the file contains a single color in RRGGBB format, for example:
+//
+//	000000
+//	1d2b53
+//	7e2553
+func DecodePaletteHex(hexFile []byte) PaletteArray {
+	p, err := DecodePaletteHexOrErr(hexFile)
+	if err != nil {
+		panic("DecodePaletteHex failed: " + err.Error())
+	}
+	return p
+}
+
+// DecodePaletteHexOrErr works like DecodePaletteHex but returns an error
+// if the file is invalid or contains too many colors.
+func DecodePaletteHexOrErr(hexFile []byte) (PaletteArray, error) {
+	var p PaletteArray
+	n := 0
+
+	err := forEachLine(hexFile, func(lineNo int, line string) error {
+		line = strings.TrimPrefix(line, "#")
+		if line == "" {
+			return nil
+		}
+		rgb, err := strconv.ParseUint(line, 16, 32)
+		if err != nil || len(line) != 6 {
+			return fmt.Errorf("line %d: invalid color %q", lineNo, line)
+		}
+		if n == MaxColors {
+			return errToManyColors
+		}
+		p[n] = RGB(rgb)
+		n++
+		return nil
+	})
+	if err != nil {
+		return PaletteArray{}, err
+	}
+
+	return p, nil
+}
+
+// EncodePaletteHex encodes colors into a Lospec .hex file.
+//
+// To encode only the colors used by your game, pass a slice of the palette,
+// for example: EncodePaletteHex(pi.Palette[:32])
+func EncodePaletteHex(colors []RGB) []byte {
+	var b bytes.Buffer
+	for _, c := range colors {
+		_, _ = fmt.Fprintf(&b, "%06x\n", uint32(c))
+	}
+	return b.Bytes()
+}
+
+// DecodePaletteGPL decodes a palette from a GIMP .gpl file.
+func DecodePaletteGPL(gplFile []byte) PaletteArray {
+	p, err := DecodePaletteGPLOrErr(gplFile)
+	if err != nil {
+		panic("DecodePaletteGPL failed: " + err.Error())
+	}
+	return p
+}
+
+// DecodePaletteGPLOrErr works like DecodePaletteGPL but returns an error
+// if the file is invalid or contains too many colors.
+func DecodePaletteGPLOrErr(gplFile []byte) (PaletteArray, error) {
+	var p PaletteArray
+	n := 0
+
+	err := forEachLine(gplFile, func(lineNo int, line string) error {
+		if lineNo == 1 {
+			if line != "GIMP Palette" {
+				return errors.New("missing GIMP Palette header")
+			}
+			return nil
+		}
+		if line == "" || strings.HasPrefix(line, "#") ||
+			strings.HasPrefix(line, "Name:") || strings.HasPrefix(line, "Columns:") {
+			return nil
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 3 {
+			return fmt.Errorf("line %d: invalid color %q", lineNo, line)
+		}
+		rgb, err := parseRGB(fields[0], fields[1], fields[2])
+		if err != nil {
+			return fmt.Errorf("line %d: %w", lineNo, err)
+		}
+		if n == MaxColors {
+			return errToManyColors
+		}
+		p[n] = rgb
+		n++
+		return nil
+	})
+	if err != nil {
+		return PaletteArray{}, err
+	}
+
+	return p, nil
+}
+
+// EncodePaletteGPL encodes colors into a GIMP .gpl file.
+//
+// To encode only the colors used by your game, pass a slice of the palette,
+// for example: EncodePaletteGPL(pi.Palette[:32])
+func EncodePaletteGPL(colors []RGB) []byte {
+	var b bytes.Buffer
+	b.WriteString("GIMP Palette\n")
+	b.WriteString("#\n")
+	for _, c := range colors {
+		r, g, bl := c.RGB()
+		_, _ = fmt.Fprintf(&b, "%3d %3d %3d\t%s\n", r, g, bl, c)
+	}
+	return b.Bytes()
+}
+
+// DecodePaletteJASC decodes a palette from a JASC .pal file
+// (Paint Shop Pro palette, also exported by Aseprite).
+func DecodePaletteJASC(palFile []byte) PaletteArray {
+	p, err := DecodePaletteJASCOrErr(palFile)
+	if err != nil {
+		panic("DecodePaletteJASC failed: " + err.Error())
+	}
+	return p
+}
+
+// DecodePaletteJASCOrErr works like DecodePaletteJASC but returns an error
+// if the file is invalid, contains too many colors or fewer colors
+// than declared in the header.
+func DecodePaletteJASCOrErr(palFile []byte) (PaletteArray, error) {
+	var p PaletteArray
+	n := 0
+	count := 0
+
+	err := forEachLine(palFile, func(lineNo int, line string) error {
+		switch {
+		case lineNo == 1:
+			if line != "JASC-PAL" {
+				return errors.New("missing JASC-PAL header")
+			}
+			return nil
+		case lineNo == 2: // version
+			return nil
+		case lineNo == 3:
+			var err error
+			count, err = strconv.Atoi(line)
+			if err != nil {
+				return fmt.Errorf("line %d: invalid number of colors %q", lineNo, line)
+			}
+			if count > MaxColors {
+				return errToManyColors
+			}
+			return nil
+		case line == "" || n == count:
+			return nil
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 3 {
+			return fmt.Errorf("line %d: invalid color %q", lineNo, line)
+		}
+		rgb, err := parseRGB(fields[0], fields[1], fields[2])
+		if err != nil {
+			return fmt.Errorf("line %d: %w", lineNo, err)
+		}
+		p[n] = rgb
+		n++
+		return nil
+	})
+	if err != nil {
+		return PaletteArray{}, err
+	}
+	if n < count {
+		return PaletteArray{}, fmt.Errorf("expected %d colors, got %d", count, n)
+	}
+
+	return p, nil
+}
+
+// EncodePaletteJASC encodes colors into a JASC .pal file.
+//
+// To encode only the colors used by your game, pass a slice of the palette,
+// for example: EncodePaletteJASC(pi.Palette[:32])
+func EncodePaletteJASC(colors []RGB) []byte {
+	var b bytes.Buffer
+	b.WriteString("JASC-PAL\r\n0100\r\n")
+	_, _ = fmt.Fprintf(&b, "%d\r\n", len(colors))
+	for _, c := range colors {
+		r, g, bl := c.RGB()
+		_, _ = fmt.Fprintf(&b, "%d %d %d\r\n", r, g, bl)
+	}
+	return b.Bytes()
+}
+
+// DecodePaletteAseprite decodes a palette from an Aseprite file (.ase or .aseprite).
+func DecodePaletteAseprite(aseFile []byte) PaletteArray {
+	p, err := DecodePaletteAsepriteOrErr(aseFile)
+	if err != nil {
+		panic("DecodePaletteAseprite failed: " + err.Error())
+	}
+	return p
+}
+
+// DecodePaletteAsepriteOrErr works like DecodePaletteAseprite but returns an error
+// if the file is invalid or its palette contains too many colors.
+func DecodePaletteAsepriteOrErr(aseFile []byte) (PaletteArray, error) {
+	file, err := aseprite.Decode(aseFile)
+	if err != nil {
+		return PaletteArray{}, fmt.Errorf("Aseprite decoding failed: %w", err)
+	}
+
+	colors, err := file.Palette()
+	if err != nil {
+		return PaletteArray{}, fmt.Errorf("Aseprite decoding failed: %w", err)
+	}
+
+	if len(colors) > MaxColors {
+		return PaletteArray{}, errToManyColors
+	}
+
+	var p PaletteArray
+	for i, c := range colors {
+		p[i] = FromRGB(c.R, c.G, c.B)
+	}
+	return p, nil
+}
+
+// EncodePaletteAseprite encodes colors into an Aseprite file,
+// which can be loaded by Aseprite as a palette.
+//
+// To encode only the colors used by your game, pass a slice of the palette,
+// for example: EncodePaletteAseprite(pi.Palette[:32])
+func EncodePaletteAseprite(colors []RGB) []byte {
+	palette := make([]color.NRGBA, len(colors))
+	for i, c := range colors {
+		r, g, b := c.RGB()
+		palette[i] = color.NRGBA{R: r, G: g, B: b, A: 255}
+	}
+	return aseprite.EncodePalette(palette)
+}
+
+// forEachLine calls f for each trimmed line. Line numbers start at 1.
+func forEachLine(file []byte, f func(lineNo int, line string) error) error {
+	scanner := bufio.NewScanner(bytes.NewReader(file))
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		if err := f(lineNo, strings.TrimSpace(scanner.Text())); err != nil {
+			return err
+		}
+	}
+	return scanner.Err() //nolint:wrapcheck
+}
+
+func parseRGB(r, g, b string) (RGB, error) {
+	var components [3]uint8
+	for i, s := range [3]string{r, g, b} {
+		v, err := strconv.ParseUint(s, 10, 8)
+		if err != nil {
+			return 0, fmt.Errorf("invalid color component %q", s)
+		}
+		components[i] = uint8(v)
+	}
+	return FromRGB(components[0], components[1], components[2]), nil
+}
@@ -0,0 +1,135 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pi_test
+
+import (
+	_ "embed"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elgopher/pi"
+)
+
+//go:embed internal/test/aseprite/gamepad.ase
+var gamepadAse []byte
+
+var paletteFormats = map[string]struct {
+	decode func([]byte) (pi.PaletteArray, error)
+	encode func([]pi.RGB) []byte
+}{
+	"hex":      {decode: pi.DecodePaletteHexOrErr, encode: pi.EncodePaletteHex},
+	"gpl":      {decode: pi.DecodePaletteGPLOrErr, encode: pi.EncodePaletteGPL},
+	"jasc":     {decode: pi.DecodePaletteJASCOrErr, encode: pi.EncodePaletteJASC},
+	"aseprite": {decode: pi.DecodePaletteAsepriteOrErr, encode: pi.EncodePaletteAseprite},
+}
+
+func TestPaletteFormats(t *testing.T) {
+	for formatName, format := range paletteFormats {
+		t.Run(formatName, func(t *testing.T) {
+			t.Run("should encode palette which can be decoded back", func(t *testing.T) {
+				colors := []pi.RGB{0x000000, 0x1D2B53, 0xFFFFFF, 0x7E2553}
+				// when
+				file := format.encode(colors)
+				// then
+				palette, err := format.decode(file)
+				require.NoError(t, err)
+				expected := pi.PaletteArray{0x000000, 0x1D2B53, 0xFFFFFF, 0x7E2553}
+				assert.Equal(t, expected, palette)
+			})
+
+			t.Run("should decode maximum number of colors", func(t *testing.T) {
+				palette := pi.PaletteArray{}
+				for i := range palette {
+					palette[i] = pi.RGB(i)
+				}
+				file := format.encode(palette[:])
+				// when
+				decoded, err := format.decode(file)
+				// then
+				require.NoError(t, err)
+				assert.Equal(t, palette, decoded)
+			})
+
+			t.Run("should return error when palette has too many colors", func(t *testing.T) {
+				file := format.encode(make([]pi.RGB, pi.MaxColors+1))
+				// when
+				palette, err := format.decode(file)
+				// then
+				require.ErrorContains(t, err, "too many colors")
+				assert.Zero(t, palette)
+			})
+
+			t.Run("should return error for invalid file", func(t *testing.T) {
+				palette, err := format.decode([]byte("invalid"))
+				require.Error(t, err)
+				assert.Zero(t, palette)
+			})
+		})
+	}
+}
+
+func TestDecodePaletteHexOrErr(t *testing.T) {
+	file := "000000\r\n#1d2b53\n\n7E2553\n"
+	// when
+	palette, err := pi.DecodePaletteHexOrErr([]byte(file))
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, pi.PaletteArray{0x000000, 0x1D2B53, 0x7E2553}, palette)
+}
+
+func TestDecodePaletteGPLOrErr(t *testing.T) {
+	file := strings.Join([]string{
+		"GIMP Palette",
+		"Name: PICO-8",
+		"Columns: 4",
+		"# comment",
+		"  0   0   0	black",
+		" 29  43  83	dark blue",
+		"126  37  83",
+	}, "\n")
+	// when
+	palette, err := pi.DecodePaletteGPLOrErr([]byte(file))
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, pi.PaletteArray{0x000000, 0x1D2B53, 0x7E2553}, palette)
+}
+
+func TestDecodePaletteJASCOrErr(t *testing.T) {
+	t.Run("should decode", func(t *testing.T) {
+		file := "JASC-PAL\r\n0100\r\n3\r\n0 0 0\r\n29 43 83\r\n126 37 83\r\n"
+		// when
+		palette, err := pi.DecodePaletteJASCOrErr([]byte(file))
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, pi.PaletteArray{0x000000, 0x1D2B53, 0x7E2553}, palette)
+	})
+
+	t.Run("should return error for invalid color component", func(t *testing.T) {
+		file := "JASC-PAL\n0100\n1\n0 256 0\n"
+		// when
+		_, err := pi.DecodePaletteJASCOrErr([]byte(file))
+		// then
+		require.ErrorContains(t, err, "line 4")
+	})
+
+	t.Run("should return error when there are fewer colors than declared", func(t *testing.T) {
+		file := "JASC-PAL\n0100\n3\n0 0 0\n29 43 83\n"
+		// when
+		_, err := pi.DecodePaletteJASCOrErr([]byte(file))
+		// then
+		require.ErrorContains(t, err, "expected 3 colors, got 2")
+	})
+}
+
+func TestDecodePaletteAsepriteOrErr(t *testing.T) {
+	// when
+	palette, err := pi.DecodePaletteAsepriteOrErr(gamepadAse)
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, pi.RGB(0x060608), palette[0])
+	assert.Equal(t, pi.RGB(0x141013), palette[1])
+}
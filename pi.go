@@ -131,16 +131,23 @@ func setPixelWithColor(x, y int, draw Color) {
 		return
 	}
 
+	if patternBit(x, y) {
+		if fillPattern.Transparent {
+			return
+		}
+		draw = fillPattern.Color & ReadMask
+	}
+
 	idx := y*drawTarget.width + x
 	target := drawTarget.data[idx] & ShapeTargetMask
 
-	drawTarget.data[idx] = ColorTables[(draw|target)>>6][drawColor&(MaxColors-1)][target&(MaxColors-1)]
+	drawTarget.data[idx] = ColorTables[(draw|target)>>6][draw&(MaxColors-1)][target&(MaxColors-1)]
 }
 
 // SetPixel sets the draw color at the given coordinates.
 //
 // It takes into account the camera position, clipping region,
-// color tables, and masks.
+// color tables, masks, and fill pattern.
 func SetPixel(x, y int) {
 	setPixelWithColor(x, y, drawColor&ReadMask)
 }
@@ -0,0 +1,266 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+// Package pianim provides sprite animations.
+//
+// An Animation is a sequence of sprite frames, each displayed for
+// a given number of ticks. Animations are played by a Player,
+// which holds multiple named animations (clips), advances the current
+// frame on each tick and draws it.
+package pianim
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/elgopher/pi"
+	"github.com/elgopher/pi/pievent"
+	"github.com/elgopher/pi/piloop"
+)
+
+// Mode specifies how the animation is played.
+type Mode int
+
+const (
+	Loop        Mode = iota // play frames from first to last and start again
+	Once                    // play frames from first to last and stop on the last one
+	PingPong                // play frames forward, then backward, and start again
+	Reverse                 // play frames from last to first and start again
+	ReverseOnce             // play frames from last to first and stop on the first one
+)
+
+// Event is published by the Player when a frame with this event is reached.
+type Event string
+
+// EventFinished is published when the animation played in Once or ReverseOnce
+// mode has finished.
+const EventFinished Event = "finished"
+
+// Frame is a single animation frame.
+type Frame struct {
+	Sprite pi.Sprite
+	// Duration is the number of ticks the frame is displayed.
+	// Values lower than 1 are treated as 1.
+	Duration int
+	// Event is published when the frame is reached. Empty means no event.
+	Event Event
+}
+
+// Animation is a sequence of frames.
+type Animation struct {
+	Frames []Frame
+	Mode   Mode
+}
+
+// New creates an Animation where each sprite is displayed for the same
+// number of ticks.
+func New(mode Mode, duration int, sprites ...pi.Sprite) Animation {
+	frames := make([]Frame, len(sprites))
+	for i, sprite := range sprites {
+		frames[i] = Frame{Sprite: sprite, Duration: duration}
+	}
+	return Animation{Frames: frames, Mode: mode}
+}
+
+// Duration returns the number of ticks needed to display all frames once.
+func (a Animation) Duration() int {
+	d := 0
+	for _, f := range a.Frames {
+		d += max(f.Duration, 1)
+	}
+	return d
+}
+
+// Seconds converts seconds to ticks, using the current pi.TPS.
+//
+// For example, Seconds(0.5) returns 15 when TPS is 30.
+func Seconds(s float64) int {
+	return int(math.Round(s * float64(pi.TPS())))
+}
+
+// Player plays named animations (clips).
+type Player struct {
+	Clips map[string]Animation
+
+	clip      string
+	animation Animation
+	frame     int
+	ticks     int
+	step      int
+	finished  bool
+	paused    bool
+	target    pievent.Target[Event]
+}
+
+// NewPlayer creates a Player with the given clips. No clip is played
+// until Play is called.
+func NewPlayer(clips map[string]Animation) *Player {
+	return &Player{
+		Clips:  clips,
+		target: pievent.NewTarget[Event](),
+	}
+}
+
+// Target returns the target where frame events and EventFinished are published.
+func (p *Player) Target() pievent.Target[Event] {
+	return p.target
+}
+
+// Play starts playing the clip with the given name from the beginning.
+//
+// If the clip is already playing, nothing happens. Use Restart to start
+// the current clip again.
+//
+// It panics when there is no clip with the given name.
+func (p *Player) Play(clip string) {
+	if clip == p.clip && p.animation.Frames != nil {
+		return
+	}
+	animation, ok := p.Clips[clip]
+	if !ok {
+		panic(fmt.Sprintf("pianim: clip %q not found", clip))
+	}
+	p.clip = clip
+	p.animation = animation
+	p.Restart()
+}
+
+// Restart plays the current clip from the beginning.
+func (p *Player) Restart() {
+	p.ticks = 0
+	p.finished = false
+	p.paused = false
+	p.step = 1
+	first := 0
+	if p.animation.Mode == Reverse || p.animation.Mode == ReverseOnce {
+		first = len(p.animation.Frames) - 1
+		p.step = -1
+	}
+	if len(p.animation.Frames) > 0 {
+		p.setFrame(first)
+	}
+}
+
+// Clip returns the name of the current clip.
+func (p *Player) Clip() string {
+	return p.clip
+}
+
+// Frame returns the index of the current frame in the current clip.
+func (p *Player) Frame() int {
+	return p.frame
+}
+
+// SetFrame jumps to the frame with the given index. The event of the frame
+// is published.
+func (p *Player) SetFrame(frame int) {
+	if frame < 0 || frame >= len(p.animation.Frames) {
+		return
+	}
+	p.ticks = 0
+	p.finished = false
+	p.setFrame(frame)
+}
+
+// Finished reports whether the clip played in Once or ReverseOnce mode
+// has finished.
+func (p *Player) Finished() bool {
+	return p.finished
+}
+
+// Pause stops advancing frames. The current frame is still drawn.
+func (p *Player) Pause() {
+	p.paused = true
+}
+
+// Resume resumes advancing frames after Pause.
+func (p *Player) Resume() {
+	p.paused = false
+}
+
+// Paused reports whether the Player is paused.
+func (p *Player) Paused() bool {
+	return p.paused
+}
+
+// Tick advances the animation by a single tick.
+func (p *Player) Tick() {
+	frames := p.animation.Frames
+	if p.finished || p.paused || len(frames) == 0 {
+		return
+	}
+
+	p.ticks++
+	if p.ticks < frames[p.frame].Duration {
+		return
+	}
+	p.ticks = 0
+
+	next, ok := p.next()
+	if !ok {
+		p.finished = true
+		p.target.Publish(EventFinished)
+		return
+	}
+	p.setFrame(next)
+}
+
+func (p *Player) next() (int, bool) {
+	n := len(p.animation.Frames)
+	switch p.animation.Mode {
+	case Once:
+		return p.frame + 1, p.frame+1 < n
+	case ReverseOnce:
+		return p.frame - 1, p.frame > 0
+	case Reverse:
+		return (p.frame - 1 + n) % n, true
+	case PingPong:
+		if n == 1 {
+			return 0, true
+		}
+		if next := p.frame + p.step; next < 0 || next >= n {
+			p.step = -p.step
+		}
+		return p.frame + p.step, true
+	default:
+		return (p.frame + 1) % n, true
+	}
+}
+
+func (p *Player) setFrame(frame int) {
+	p.frame = frame
+	if event := p.animation.Frames[frame].Event; event != "" {
+		p.target.Publish(event)
+	}
+}
+
+// ScheduleOn schedules the Player to tick on the given event,
+// usually piloop.EventUpdate.
+//
+// Returns a handler that can be unregistered from piloop.Target
+// to stop further ticking.
+func (p *Player) ScheduleOn(event piloop.Event) pievent.Handler {
+	return piloop.Target().Subscribe(event, func(piloop.Event, pievent.Handler) {
+		p.Tick()
+	})
+}
+
+// Sprite returns the sprite of the current frame.
+//
+// It returns a zero sprite when no clip is played.
+func (p *Player) Sprite() pi.Sprite {
+	if len(p.animation.Frames) == 0 {
+		return pi.Sprite{}
+	}
+	return p.animation.Frames[p.frame].Sprite
+}
+
+// Draw draws the current frame using pi.DrawSprite.
+func (p *Player) Draw(x, y int) {
+	pi.DrawSprite(p.Sprite(), x, y)
+}
+
+// Stretch draws the current frame using pi.Stretch.
+func (p *Player) Stretch(x, y, w, h int) {
+	pi.Stretch(p.Sprite(), x, y, w, h)
+}
@@ -0,0 +1,214 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pianim_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elgopher/pi"
+	"github.com/elgopher/pi/pianim"
+	"github.com/elgopher/pi/pievent"
+	"github.com/elgopher/pi/piloop"
+)
+
+var sheet = pi.NewCanvas(3, 1)
+
+func sprite(i int) pi.Sprite {
+	return pi.SpriteFrom(sheet, i, 0, 1, 1)
+}
+
+// playedFrames ticks the player n times and returns the frame after each tick.
+func playedFrames(player *pianim.Player, n int) []int {
+	frames := []int{player.Frame()}
+	for i := 0; i < n; i++ {
+		player.Tick()
+		frames = append(frames, player.Frame())
+	}
+	return frames
+}
+
+func TestPlayer_Tick(t *testing.T) {
+	tests := map[string]struct {
+		mode     pianim.Mode
+		expected []int
+	}{
+		"loop":         {mode: pianim.Loop, expected: []int{0, 1, 2, 0, 1, 2, 0}},
+		"once":         {mode: pianim.Once, expected: []int{0, 1, 2, 2, 2, 2, 2}},
+		"ping-pong":    {mode: pianim.PingPong, expected: []int{0, 1, 2, 1, 0, 1, 2}},
+		"reverse":      {mode: pianim.Reverse, expected: []int{2, 1, 0, 2, 1, 0, 2}},
+		"reverse once": {mode: pianim.ReverseOnce, expected: []int{2, 1, 0, 0, 0, 0, 0}},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			player := pianim.NewPlayer(map[string]pianim.Animation{
+				"clip": pianim.New(test.mode, 1, sprite(0), sprite(1), sprite(2)),
+			})
+			player.Play("clip")
+			// when
+			frames := playedFrames(player, 6)
+			// then
+			assert.Equal(t, test.expected, frames)
+		})
+	}
+
+	t.Run("should display frame for the duration", func(t *testing.T) {
+		player := pianim.NewPlayer(map[string]pianim.Animation{
+			"clip": {Frames: []pianim.Frame{
+				{Sprite: sprite(0), Duration: 3},
+				{Sprite: sprite(1), Duration: 1},
+				{Sprite: sprite(2)},
+			}},
+		})
+		player.Play("clip")
+		// when
+		frames := playedFrames(player, 6)
+		// then
+		assert.Equal(t, []int{0, 0, 0, 1, 2, 0, 0}, frames)
+	})
+
+	t.Run("should not advance when paused", func(t *testing.T) {
+		player := pianim.NewPlayer(map[string]pianim.Animation{
+			"clip": pianim.New(pianim.Loop, 1, sprite(0), sprite(1)),
+		})
+		player.Play("clip")
+		player.Pause()
+		// when
+		player.Tick()
+		// then
+		assert.Equal(t, 0, player.Frame())
+		assert.True(t, player.Paused())
+		// and when
+		player.Resume()
+		player.Tick()
+		// then
+		assert.Equal(t, 1, player.Frame())
+	})
+
+	t.Run("should do nothing when no clip is played", func(t *testing.T) {
+		player := pianim.NewPlayer(nil)
+		player.Tick()
+		assert.Equal(t, pi.Sprite{}, player.Sprite())
+	})
+}
+
+func TestPlayer_Play(t *testing.T) {
+	clips := map[string]pianim.Animation{
+		"idle": pianim.New(pianim.Loop, 1, sprite(0), sprite(1)),
+		"jump": pianim.New(pianim.Once, 1, sprite(2)),
+	}
+
+	t.Run("should switch clips", func(t *testing.T) {
+		player := pianim.NewPlayer(clips)
+		player.Play("idle")
+		player.Tick()
+		// when
+		player.Play("jump")
+		// then
+		assert.Equal(t, "jump", player.Clip())
+		assert.Equal(t, 0, player.Frame())
+		assert.Equal(t, sprite(2), player.Sprite())
+	})
+
+	t.Run("should continue when the same clip is played", func(t *testing.T) {
+		player := pianim.NewPlayer(clips)
+		player.Play("idle")
+		player.Tick()
+		// when
+		player.Play("idle")
+		// then
+		assert.Equal(t, 1, player.Frame())
+	})
+
+	t.Run("should start again on Restart", func(t *testing.T) {
+		player := pianim.NewPlayer(clips)
+		player.Play("idle")
+		player.Tick()
+		// when
+		player.Restart()
+		// then
+		assert.Equal(t, 0, player.Frame())
+	})
+
+	t.Run("should panic when clip is not found", func(t *testing.T) {
+		player := pianim.NewPlayer(clips)
+		assert.Panics(t, func() {
+			player.Play("missing")
+		})
+	})
+}
+
+func TestPlayer_Target(t *testing.T) {
+	t.Run("should publish frame events and finished event", func(t *testing.T) {
+		player := pianim.NewPlayer(map[string]pianim.Animation{
+			"attack": {
+				Mode: pianim.Once,
+				Frames: []pianim.Frame{
+					{Sprite: sprite(0), Event: "start"},
+					{Sprite: sprite(1), Event: "hit"},
+					{Sprite: sprite(2)},
+				},
+			},
+		})
+		var events []pianim.Event
+		player.Target().SubscribeAll(func(event pianim.Event, _ pievent.Handler) {
+			events = append(events, event)
+		})
+		// when
+		player.Play("attack")
+		playedFrames(player, 4)
+		// then
+		assert.Equal(t, []pianim.Event{"start", "hit", pianim.EventFinished}, events)
+		assert.True(t, player.Finished())
+	})
+}
+
+func TestPlayer_ScheduleOn(t *testing.T) {
+	player := pianim.NewPlayer(map[string]pianim.Animation{
+		"clip": pianim.New(pianim.Loop, 1, sprite(0), sprite(1)),
+	})
+	player.Play("clip")
+	handler := player.ScheduleOn(piloop.EventUpdate)
+	defer piloop.Target().Unsubscribe(handler)
+	// when
+	piloop.Target().Publish(piloop.EventUpdate)
+	// then
+	assert.Equal(t, 1, player.Frame())
+}
+
+func TestPlayer_Draw(t *testing.T) {
+	pi.SetScreenSize(2, 1)
+	pi.SetDrawTarget(pi.Screen())
+	pi.Cls()
+	sheet.SetAll(7, 8, 9)
+	player := pianim.NewPlayer(map[string]pianim.Animation{
+		"clip": pianim.New(pianim.Loop, 1, sprite(0), sprite(1)),
+	})
+	player.Play("clip")
+	player.Tick()
+	// when
+	player.Draw(1, 0)
+	// then
+	assert.Equal(t, []pi.Color{0, 8}, pi.Screen().Data())
+	// and when
+	player.Stretch(0, 0, 2, 1)
+	// then
+	assert.Equal(t, []pi.Color{8, 8}, pi.Screen().Data())
+}
+
+func TestAnimation_Duration(t *testing.T) {
+	a := pianim.Animation{Frames: []pianim.Frame{{Duration: 3}, {Duration: 0}, {Duration: 2}}}
+	assert.Equal(t, 6, a.Duration())
+}
+
+func TestSeconds(t *testing.T) {
+	prevTPS := pi.TPS()
+	t.Cleanup(func() {
+		pi.SetTPS(prevTPS)
+	})
+	pi.SetTPS(30)
+	require.Equal(t, 15, pianim.Seconds(0.5))
+}
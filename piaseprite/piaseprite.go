@@ -0,0 +1,377 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+// Package piaseprite decodes Aseprite files (.ase and .aseprite)
+// into canvases, frames, tags and slices.
+//
+// Only files with indexed color mode are supported.
+package piaseprite
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+
+	"github.com/elgopher/pi"
+	"github.com/elgopher/pi/internal"
+	"github.com/elgopher/pi/internal/aseprite"
+)
+
+// File is a decoded Aseprite file.
+type File struct {
+	Width, Height int
+	Layers        []Layer
+	Frames        []Frame
+	Tags          []Tag
+	Slices        []Slice
+	// PaletteRemapped is true when the file palette is different
+	// from pi.Palette and pixel colors were replaced with the closest
+	// colors from pi.Palette.
+	PaletteRemapped bool
+}
+
+// Layer describes a layer in the file.
+type Layer struct {
+	Name string
+	// Visible is false when the layer or any of its parent groups is hidden.
+	Visible bool
+	// Group is true for group layers. Group layers have no pixels.
+	Group bool
+	// Parent is the index of the parent group, or -1 for top-level layers.
+	Parent int
+}
+
+// Frame is a single animation frame.
+type Frame struct {
+	// Canvas contains all visible layers flattened.
+	Canvas pi.Canvas
+	// Layers contains a canvas for each layer, in the same order as File.Layers.
+	// Pixels of hidden layers are present too.
+	Layers []pi.Canvas
+	// Duration in seconds.
+	Duration float64
+}
+
+// Direction is an animation direction of a Tag.
+type Direction int
+
+const (
+	Forward         Direction = aseprite.DirectionForward
+	Reverse         Direction = aseprite.DirectionReverse
+	PingPong        Direction = aseprite.DirectionPingPong
+	PingPongReverse Direction = aseprite.DirectionPingPongReverse
+)
+
+// Tag is a named range of frames.
+type Tag struct {
+	Name      string
+	From, To  int // inclusive
+	Direction Direction
+	// Repeat is the number of times the animation is played. 0 means infinite.
+	Repeat int
+}
+
+// Slice is a named area of the canvas, which can change over frames.
+type Slice struct {
+	Name string
+	Keys []SliceKey // sorted by frame
+}
+
+// SliceKey describes the slice starting from the Frame.
+type SliceKey struct {
+	Frame int
+	Area  pi.IntArea
+	// Center is the center area of 9-patch slice, relative to Area.
+	// It is zero when slice is not a 9-patch.
+	Center pi.IntArea
+	// Pivot is relative to Area. It is zero when slice does not have a pivot.
+	Pivot pi.Position
+}
+
+// Key returns the slice key active in the given frame.
+func (s Slice) Key(frame int) (SliceKey, bool) {
+	var key SliceKey
+	found := false
+	for _, k := range s.Keys {
+		if k.Frame > frame {
+			break
+		}
+		key, found = k, true
+	}
+	return key, found
+}
+
+// Slice returns the slice with the given name.
+func (f File) Slice(name string) (Slice, bool) {
+	for _, s := range f.Slices {
+		if s.Name == name {
+			return s, true
+		}
+	}
+	return Slice{}, false
+}
+
+// Tag returns the tag with the given name.
+func (f File) Tag(name string) (Tag, bool) {
+	for _, t := range f.Tags {
+		if t.Name == name {
+			return t, true
+		}
+	}
+	return Tag{}, false
+}
+
+// SliceSprite returns a sprite of the slice in the given frame,
+// using the flattened frame canvas as a source.
+func (f File) SliceSprite(name string, frame int) (pi.Sprite, bool) {
+	s, ok := f.Slice(name)
+	if !ok || frame < 0 || frame >= len(f.Frames) {
+		return pi.Sprite{}, false
+	}
+	key, ok := s.Key(frame)
+	if !ok {
+		return pi.Sprite{}, false
+	}
+	a := key.Area
+	return pi.SpriteFrom(f.Frames[frame].Canvas, a.X, a.Y, a.W, a.H), true
+}
+
+// Decode decodes an Aseprite file. It panics if the file is invalid
+// or does not use indexed color mode.
+//
+// Colors are checked against pi.Palette. When palettes are different,
+// each color is replaced with the closest color from pi.Palette.
+// To use the palette from the file, set pi.Palette using
+// pi.DecodePaletteAseprite before calling Decode.
+//
+// Pixels with the transparent color index are not drawn when
+// flattening layers, except pixels of the background layer.
+func Decode(aseFile []byte) File {
+	f, err := DecodeOrErr(aseFile)
+	if err != nil {
+		panic("piaseprite.Decode failed: " + err.Error())
+	}
+	return f
+}
+
+// DecodeOrErr is like Decode but returns an error.
+func DecodeOrErr(aseFile []byte) (File, error) {
+	raw, err := aseprite.Decode(aseFile)
+	if err != nil {
+		return File{}, fmt.Errorf("Aseprite decoding failed: %w", err)
+	}
+
+	if raw.ColorDepth != aseprite.DepthIndexed {
+		return File{}, errors.New("only indexed color mode is supported")
+	}
+
+	colorMap, remapped, err := paletteMapping(raw)
+	if err != nil {
+		return File{}, err
+	}
+
+	f := File{
+		Width:           raw.Width,
+		Height:          raw.Height,
+		PaletteRemapped: remapped,
+	}
+
+	var cels [][]aseprite.Cel // cels[frame]
+	var background []bool     // background[layer]
+
+	for i, rawFrame := range raw.FrameList {
+		var frameCels []aseprite.Cel
+
+		for _, chunk := range rawFrame.Chunks {
+			switch chunk.Type {
+			case aseprite.ChunkLayer:
+				l, err := aseprite.ParseLayer(chunk.Data)
+				if err != nil {
+					return File{}, err //nolint:wrapcheck
+				}
+				f.Layers = append(f.Layers, newLayer(f.Layers, l))
+				background = append(background, l.Background)
+			case aseprite.ChunkCel:
+				c, err := aseprite.ParseCel(chunk.Data, 1, raw.Width, raw.Height)
+				if err != nil {
+					return File{}, err //nolint:wrapcheck
+				}
+				if c.Type == aseprite.CelLinked {
+					linked, ok := findCel(cels, c.LinkedFrame, c.Layer)
+					if !ok {
+						return File{}, fmt.Errorf("frame %d: linked cel not found", i)
+					}
+					c = linked
+				}
+				frameCels = append(frameCels, c)
+			case aseprite.ChunkTags:
+				tags, err := aseprite.ParseTags(chunk.Data)
+				if err != nil {
+					return File{}, err //nolint:wrapcheck
+				}
+				for _, t := range tags {
+					f.Tags = append(f.Tags, Tag{
+						Name:      t.Name,
+						From:      t.From,
+						To:        t.To,
+						Direction: Direction(t.Direction),
+						Repeat:    t.Repeat,
+					})
+				}
+			case aseprite.ChunkSlice:
+				s, err := aseprite.ParseSlice(chunk.Data)
+				if err != nil {
+					return File{}, err //nolint:wrapcheck
+				}
+				f.Slices = append(f.Slices, newSlice(s))
+			}
+		}
+
+		cels = append(cels, frameCels)
+	}
+
+	transparent := colorMap[raw.TransparentIndex]
+
+	for i, rawFrame := range raw.FrameList {
+		frame := Frame{
+			Canvas:   pi.NewCanvas(raw.Width, raw.Height),
+			Layers:   make([]pi.Canvas, len(f.Layers)),
+			Duration: float64(rawFrame.Duration) / 1000,
+		}
+		frame.Canvas.Clear(transparent)
+		for l := range frame.Layers {
+			frame.Layers[l] = pi.NewCanvas(raw.Width, raw.Height)
+			frame.Layers[l].Clear(transparent)
+		}
+
+		for _, c := range cels[i] {
+			if c.Layer < 0 || c.Layer >= len(f.Layers) {
+				return File{}, fmt.Errorf("frame %d: cel references invalid layer %d", i, c.Layer)
+			}
+			if c.Type == aseprite.CelTilemap {
+				return File{}, fmt.Errorf("frame %d: tilemap cels are not supported", i)
+			}
+			drawCel(frame.Layers[c.Layer], c, raw.TransparentIndex, background[c.Layer], colorMap)
+		}
+
+		// cels are stored in arbitrary order, so flatten layers in layer order:
+		for l, layer := range f.Layers {
+			if !layer.Visible || layer.Group {
+				continue
+			}
+			for _, c := range cels[i] {
+				if c.Layer == l {
+					drawCel(frame.Canvas, c, raw.TransparentIndex, background[l], colorMap)
+				}
+			}
+		}
+
+		f.Frames = append(f.Frames, frame)
+	}
+
+	return f, nil
+}
+
+func newLayer(layers []Layer, l aseprite.Layer) Layer {
+	layer := Layer{
+		Name:    l.Name,
+		Visible: l.Visible,
+		Group:   l.Type == aseprite.LayerGroup,
+		Parent:  -1,
+	}
+
+	// the parent is the closest preceding group with lower child level:
+	level := l.ChildLevel
+	for i := len(layers) - 1; i >= 0 && level > 0; i-- {
+		if layers[i].Group && childLevel(layers, i) == level-1 {
+			layer.Parent = i
+			layer.Visible = layer.Visible && layers[i].Visible
+			break
+		}
+	}
+
+	return layer
+}
+
+func childLevel(layers []Layer, i int) int {
+	level := 0
+	for p := layers[i].Parent; p >= 0; p = layers[p].Parent {
+		level++
+	}
+	return level
+}
+
+func newSlice(s aseprite.Slice) Slice {
+	slice := Slice{Name: s.Name}
+	for _, k := range s.Keys {
+		key := SliceKey{
+			Frame: k.Frame,
+			Area:  pi.IntArea{X: k.X, Y: k.Y, W: k.Width, H: k.Height},
+		}
+		if k.HasCenter {
+			key.Center = pi.IntArea{X: k.CenterX, Y: k.CenterY, W: k.CenterW, H: k.CenterH}
+		}
+		if k.HasPivot {
+			key.Pivot = pi.Position{X: k.PivotX, Y: k.PivotY}
+		}
+		slice.Keys = append(slice.Keys, key)
+	}
+	return slice
+}
+
+func findCel(cels [][]aseprite.Cel, frame, layer int) (aseprite.Cel, bool) {
+	if frame < 0 || frame >= len(cels) {
+		return aseprite.Cel{}, false
+	}
+	for _, c := range cels[frame] {
+		if c.Layer == layer {
+			return c, true
+		}
+	}
+	return aseprite.Cel{}, false
+}
+
+// drawCel draws the cel on the canvas. Pixels with transparent index are skipped,
+// unless the cel is on the background layer.
+func drawCel(canvas pi.Canvas, c aseprite.Cel, transparent uint8, background bool, colorMap [256]pi.Color) {
+	for y := 0; y < c.Height; y++ {
+		line := c.Pixels[y*c.Width : (y+1)*c.Width]
+		for x, idx := range line {
+			if idx == transparent && !background {
+				continue
+			}
+			canvas.Set(c.X+x, c.Y+y, colorMap[idx])
+		}
+	}
+}
+
+// paletteMapping maps file color indices to pi.Palette indices.
+func paletteMapping(raw aseprite.File) (mapping [256]pi.Color, remapped bool, err error) {
+	for i := range mapping {
+		mapping[i] = pi.Color(i % pi.MaxColors)
+	}
+
+	palette, err := raw.Palette()
+	if err != nil {
+		return mapping, false, fmt.Errorf("Aseprite decoding failed: %w", err)
+	}
+
+	closestColor := internal.ClosestColorPicker[pi.RGB, pi.Color]{
+		Palette: pi.Palette,
+		Cache:   make(map[color.Color]pi.Color),
+	}
+
+	for i, c := range palette {
+		rgb := pi.FromRGB(c.R, c.G, c.B)
+		if i < pi.MaxColors && pi.Palette[i] == rgb {
+			continue
+		}
+		remapped = true
+		mapping[i], err = closestColor.IndexInPalette(color.RGBA{R: c.R, G: c.G, B: c.B, A: 255})
+		if err != nil {
+			return mapping, false, err //nolint:wrapcheck
+		}
+	}
+
+	return mapping, remapped, nil
+}
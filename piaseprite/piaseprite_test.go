@@ -0,0 +1,205 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package piaseprite_test
+
+import (
+	"encoding/binary"
+	"image/color"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elgopher/pi"
+	"github.com/elgopher/pi/internal/aseprite"
+	"github.com/elgopher/pi/piaseprite"
+)
+
+func TestDecode(t *testing.T) {
+	t.Run("should decode file created by Aseprite", func(t *testing.T) {
+		// the fixture is shared with the pi package tests:
+		gamepadAse, err := os.ReadFile("../internal/test/aseprite/gamepad.ase")
+		require.NoError(t, err)
+		pi.Palette = pi.DecodePaletteAseprite(gamepadAse)
+		// when
+		f := piaseprite.Decode(gamepadAse)
+		// then
+		assert.Equal(t, 87, f.Width)
+		assert.Equal(t, 49, f.Height)
+		assert.False(t, f.PaletteRemapped)
+		require.Len(t, f.Frames, 1)
+		require.Len(t, f.Layers, 3)
+		frame := f.Frames[0]
+		assert.Equal(t, 87, frame.Canvas.W())
+		assert.Len(t, frame.Layers, 3)
+		assert.Equal(t, 0.1, frame.Duration)
+		// flattened canvas contains pixels from all layers:
+		for _, layer := range frame.Layers {
+			for i, c := range layer.Data() {
+				if c != 0 {
+					assert.NotZero(t, frame.Canvas.Data()[i])
+				}
+			}
+		}
+		assert.NotEqual(t, make([]pi.Color, 87*49), frame.Canvas.Data())
+	})
+
+	t.Run("should decode frames, layers, tags and slices", func(t *testing.T) {
+		pi.Palette = pi.PaletteArray{0x000000, 0xFF0000, 0x00FF00, 0x0000FF}
+		file := encode(
+			aseprite.Frame{
+				Duration: 50,
+				Chunks: []aseprite.Chunk{
+					aseprite.EncodeLayer(aseprite.Layer{Name: "bottom", Visible: true}),
+					aseprite.EncodeLayer(aseprite.Layer{Name: "group", Type: aseprite.LayerGroup}),
+					aseprite.EncodeLayer(aseprite.Layer{Name: "hidden", Visible: true, ChildLevel: 1}),
+					aseprite.EncodeLayer(aseprite.Layer{Name: "top", Visible: true}),
+					aseprite.EncodeTags([]aseprite.Tag{
+						{Name: "idle", From: 0, To: 1, Direction: aseprite.DirectionPingPong, Repeat: 3},
+					}),
+					aseprite.EncodeSlice(aseprite.Slice{
+						Name: "button",
+						Keys: []aseprite.SliceKey{
+							{Frame: 1, X: 1, Y: 0, Width: 1, Height: 2, HasPivot: true, PivotX: 1, PivotY: 2},
+						},
+					}),
+					aseprite.EncodeCel(aseprite.Cel{Layer: 3, X: 1, Y: 0, Width: 1, Height: 2, Pixels: []byte{3, 0}}),
+					aseprite.EncodeCel(aseprite.Cel{Layer: 0, X: 0, Y: 0, Width: 2, Height: 2, Pixels: []byte{1, 1, 1, 1}}),
+					aseprite.EncodeCel(aseprite.Cel{Layer: 2, X: 0, Y: 0, Width: 1, Height: 1, Pixels: []byte{2}}),
+				},
+			},
+			aseprite.Frame{
+				Duration: 250,
+				Chunks: []aseprite.Chunk{
+					aseprite.EncodeCel(aseprite.Cel{Layer: 0, Type: aseprite.CelLinked, LinkedFrame: 0}),
+					aseprite.EncodeCel(aseprite.Cel{
+						Layer: 3, X: 0, Y: 1, Width: 1, Height: 1, Pixels: []byte{2}, Type: aseprite.CelCompressed,
+					}),
+				},
+			},
+		)
+		// when
+		f, err := piaseprite.DecodeOrErr(file)
+		// then
+		require.NoError(t, err)
+		assert.False(t, f.PaletteRemapped)
+		assert.Equal(t, []piaseprite.Layer{
+			{Name: "bottom", Visible: true, Parent: -1},
+			{Name: "group", Visible: false, Group: true, Parent: -1},
+			{Name: "hidden", Visible: false, Parent: 1},
+			{Name: "top", Visible: true, Parent: -1},
+		}, f.Layers)
+		require.Len(t, f.Frames, 2)
+		assert.Equal(t, 0.05, f.Frames[0].Duration)
+		assert.Equal(t, 0.25, f.Frames[1].Duration)
+		assert.Equal(t, []pi.Color{1, 3, 1, 1}, f.Frames[0].Canvas.Data())
+		assert.Equal(t, []pi.Color{2, 0, 0, 0}, f.Frames[0].Layers[2].Data())
+		assert.Equal(t, []pi.Color{1, 1, 2, 1}, f.Frames[1].Canvas.Data())
+		assert.Equal(t, []piaseprite.Tag{
+			{Name: "idle", From: 0, To: 1, Direction: piaseprite.PingPong, Repeat: 3},
+		}, f.Tags)
+		assert.Equal(t, []piaseprite.Slice{
+			{
+				Name: "button",
+				Keys: []piaseprite.SliceKey{
+					{Frame: 1, Area: pi.IntArea{X: 1, Y: 0, W: 1, H: 2}, Pivot: pi.Position{X: 1, Y: 2}},
+				},
+			},
+		}, f.Slices)
+		_, found := f.SliceSprite("button", 0)
+		assert.False(t, found)
+		sprite, found := f.SliceSprite("button", 1)
+		require.True(t, found)
+		assert.Equal(t, pi.IntArea{X: 1, Y: 0, W: 1, H: 2}, sprite.Area)
+	})
+
+	t.Run("should remap colors when palette is different", func(t *testing.T) {
+		pi.Palette = pi.PaletteArray{0x000000, 0x0000FF, 0x00FF00, 0xFF0000}
+		file := encode(aseprite.Frame{
+			Chunks: []aseprite.Chunk{
+				aseprite.EncodeLayer(aseprite.Layer{Name: "layer", Visible: true}),
+				aseprite.EncodeCel(aseprite.Cel{Width: 2, Height: 2, Pixels: []byte{0, 1, 2, 3}}),
+			},
+		})
+		// when
+		f := piaseprite.Decode(file)
+		// then
+		assert.True(t, f.PaletteRemapped)
+		assert.Equal(t, []pi.Color{0, 3, 2, 1}, f.Frames[0].Canvas.Data())
+	})
+
+	t.Run("should draw pixels with transparent index on background layer", func(t *testing.T) {
+		pi.Palette = pi.PaletteArray{0x000000, 0xFF0000, 0x00FF00, 0x0000FF}
+		file := encode(aseprite.Frame{
+			Chunks: []aseprite.Chunk{
+				aseprite.EncodeLayer(aseprite.Layer{Name: "under", Visible: true}),
+				aseprite.EncodeLayer(aseprite.Layer{Name: "background", Visible: true, Background: true}),
+				aseprite.EncodeCel(aseprite.Cel{Layer: 0, Width: 2, Height: 2, Pixels: []byte{1, 1, 1, 1}}),
+				aseprite.EncodeCel(aseprite.Cel{Layer: 1, Width: 2, Height: 2, Pixels: []byte{0, 2, 0, 2}}),
+			},
+		})
+		// when
+		f := piaseprite.Decode(file)
+		// then
+		assert.Equal(t, []pi.Color{0, 2, 0, 2}, f.Frames[0].Canvas.Data())
+	})
+
+	t.Run("should return error for invalid file", func(t *testing.T) {
+		_, err := piaseprite.DecodeOrErr([]byte{1, 2, 3})
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error for RGBA file", func(t *testing.T) {
+		file := aseprite.Encode(aseprite.File{ColorDepth: aseprite.DepthRGBA})
+		_, err := piaseprite.DecodeOrErr(file)
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when cel is bigger than canvas", func(t *testing.T) {
+		file := encode(aseprite.Frame{
+			Chunks: []aseprite.Chunk{
+				aseprite.EncodeLayer(aseprite.Layer{Name: "layer", Visible: true}),
+				aseprite.EncodeCel(aseprite.Cel{Width: 65535, Height: 65535, Type: aseprite.CelCompressed}),
+			},
+		})
+		_, err := piaseprite.DecodeOrErr(file)
+		assert.ErrorContains(t, err, "bigger than canvas")
+	})
+
+	t.Run("should return error when palette is too big", func(t *testing.T) {
+		palette := aseprite.EncodePaletteChunk(nil)
+		binary.LittleEndian.PutUint32(palette.Data, 0x7FFFFFFF) // number of colors
+		file := aseprite.Encode(aseprite.File{
+			ColorDepth: aseprite.DepthIndexed,
+			FrameList:  []aseprite.Frame{{Chunks: []aseprite.Chunk{palette}}},
+		})
+		_, err := piaseprite.DecodeOrErr(file)
+		assert.ErrorContains(t, err, "too many colors")
+	})
+
+	t.Run("should panic for invalid file", func(t *testing.T) {
+		assert.Panics(t, func() {
+			piaseprite.Decode(nil)
+		})
+	})
+}
+
+// encode encodes a 2x2 file with indexed color mode. The palette
+// containing black, red, green and blue is added to the first frame.
+func encode(frames ...aseprite.Frame) []byte {
+	palette := aseprite.EncodePaletteChunk([]color.NRGBA{
+		{A: 255},
+		{R: 255, A: 255},
+		{G: 255, A: 255},
+		{B: 255, A: 255},
+	})
+	frames[0].Chunks = append([]aseprite.Chunk{palette}, frames[0].Chunks...)
+	return aseprite.Encode(aseprite.File{
+		Width:      2,
+		Height:     2,
+		ColorDepth: aseprite.DepthIndexed,
+		FrameList:  frames,
+	})
+}
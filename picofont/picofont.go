@@ -9,6 +9,7 @@ package picofont
 
 import (
 	_ "embed"
+	"strings"
 
 	"github.com/elgopher/pi"
 	"github.com/elgopher/pi/pifont"
@@ -44,21 +45,28 @@ func init() {
 	pi.Palette[1] = 0xFFF1E8
 	canvas := pi.DecodeCanvas(fontPng)
 
-	Sheet.Chars = map[rune]pi.Sprite{}
-	idx := 0
-	for y := 0; y < 16; y++ {
-		for x := 0; x < 16; x++ {
-			width := 8
-			if idx < 128 {
-				width = 4
-			}
-			Sheet.Chars[rune(idx)] = pi.Sprite{
-				Area: pi.Area[int]{
-					X: x * 8, Y: y * 8, W: width, H: 8,
-				},
-				Source: canvas,
-			}
-			idx += 1
-		}
+	// narrow characters (0-127) are in the top half of the canvas,
+	// wide characters (128-255) in the bottom half. Each half is monospaced:
+	half := canvas.H() / 2
+	Sheet = newSheet(canvas.CloneArea(pi.IntArea{W: canvas.W(), H: half}), 0)
+	wide := newSheet(canvas.CloneArea(pi.IntArea{Y: half, W: canvas.W(), H: half}), 128)
+	for char, sprite := range wide.Chars {
+		Sheet.Chars[char] = sprite
 	}
 }
+
+func newSheet(canvas pi.Canvas, firstChar rune) pifont.Sheet {
+	var chars strings.Builder
+	for i := firstChar; i < firstChar+128; i++ {
+		chars.WriteRune(i)
+	}
+
+	return pifont.NewGridSheet(canvas, chars.String(), pifont.Grid{
+		CellW:         8,
+		CellH:         8,
+		FgColor:       Sheet.FgColor,
+		BgColor:       Sheet.BgColor,
+		LetterSpacing: 1,
+		Monospaced:    true,
+	})
+}
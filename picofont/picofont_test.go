@@ -8,6 +8,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
 	"github.com/elgopher/pi"
 	"github.com/elgopher/pi/picofont"
 	"github.com/elgopher/pi/pitest"
@@ -47,3 +49,14 @@ func TestPrint(t *testing.T) {
 		pitest.AssertSurfaceEqual(t, canvas, pi.Screen())
 	})
 }
+
+func TestSheet(t *testing.T) {
+	t.Run("should use monospaced widths", func(t *testing.T) {
+		for _, char := range []rune{'!', 'i', 'm', ' '} {
+			assert.Equal(t, 4, picofont.Sheet.Chars[char].W, "char %q", char)
+		}
+		for _, char := range []rune{128, 200, 255} {
+			assert.Equal(t, 8, picofont.Sheet.Chars[char].W, "char %d", char)
+		}
+	})
+}
@@ -0,0 +1,365 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+// Package pidialog shows dialogue text in a box, revealing characters
+// one by one like a typewriter.
+//
+// A Dialog is a pigui element. Long text is split into pages which fit
+// in the box. The player confirms each page with a key, a gamepad button
+// or by tapping the box, and can select one of the answers shown
+// on the last page. When the dialogue is over, EventFinished
+// is published to the Dialog target.
+package pidialog
+
+import (
+	"unicode/utf8"
+
+	"github.com/elgopher/pi"
+	"github.com/elgopher/pi/piaudio"
+	"github.com/elgopher/pi/pievent"
+	"github.com/elgopher/pi/pifont"
+	"github.com/elgopher/pi/pigui"
+	"github.com/elgopher/pi/pikey"
+	"github.com/elgopher/pi/pipad"
+)
+
+// Event is published by the Dialog.
+type Event struct {
+	Type   EventType
+	Dialog *Dialog
+	// Choice is the index of the selected answer. It is -1
+	// when there were no answers or the event is not EventFinished.
+	Choice int
+}
+
+type EventType string
+
+const (
+	EventPage     EventType = "page"     // a new page is shown
+	EventFinished EventType = "finished" // the last page was confirmed
+)
+
+// Dialog shows dialogue text inside its Element.
+//
+// Fields can be changed at any time. Changes of Sheet, Padding
+// and Element size are applied on the next call to Say or Ask.
+type Dialog struct {
+	Element *pigui.Element
+	Sheet   pifont.Sheet
+
+	TextColor     pi.Color
+	ChoiceColor   pi.Color
+	SelectedColor pi.Color
+	Background    pi.Color
+	Border        pi.Color // border is not drawn when equal to Background
+	Padding       int      // distance between the text and the edge of the box
+	Cursor        string   // printed before the selected answer
+
+	// TicksPerChar is the number of ticks needed to reveal a single character.
+	// When 0 or lower, the whole page is shown immediately.
+	TicksPerChar int
+
+	// Sound is played each time a character other than space is revealed.
+	// No sound is played when nil.
+	Sound       *piaudio.Sample
+	SoundChan   piaudio.Chan
+	SoundPitch  float64
+	SoundVolume float64
+
+	// ConfirmKeys and ConfirmButtons reveal the whole page when it is
+	// being revealed, show the next page, or finish the dialogue.
+	// They are ignored in the frame when Say or Ask was called, so the key
+	// which started the dialogue does not confirm the first page.
+	ConfirmKeys    []pikey.Key
+	ConfirmButtons []pipad.Button
+
+	pages        [][]string
+	choices      []string
+	linesPerPage int
+	lineHeight   int
+	page         int
+	pageLen      int // number of characters on the current page
+	revealed     int // number of revealed characters on the current page
+	ticks        int
+	selected     int
+	active       bool
+	openedFrame  int // pi.Frame when Say or Ask was called
+	target       pievent.Target[Event]
+}
+
+// Attach attaches a new dialog box with the specified size to the parent.
+//
+// The dialog is not visible until Say or Ask is called.
+func Attach(parent *pigui.Element, sheet pifont.Sheet, x, y, w, h int) *Dialog {
+	d := &Dialog{
+		Element:        pigui.Attach(parent, x, y, w, h),
+		Sheet:          sheet,
+		TextColor:      7,
+		ChoiceColor:    6,
+		SelectedColor:  10,
+		Background:     0,
+		Border:         7,
+		Padding:        3,
+		Cursor:         "> ",
+		TicksPerChar:   2,
+		SoundChan:      piaudio.Chan1,
+		SoundPitch:     1,
+		SoundVolume:    1,
+		ConfirmKeys:    []pikey.Key{pikey.Enter, pikey.Space},
+		ConfirmButtons: []pipad.Button{pipad.A},
+		target:         pievent.NewTarget[Event](),
+	}
+	d.Element.OnUpdate = d.update
+	d.Element.OnDraw = d.draw
+	d.Element.OnTap = func(pigui.Event) {
+		d.Confirm()
+	}
+	return d
+}
+
+// Target returns the target where EventPage and EventFinished are published.
+func (d *Dialog) Target() pievent.Target[Event] {
+	return d.target
+}
+
+// Say starts showing the text. Text is wrapped at spaces to fit the box
+// and split into pages.
+func (d *Dialog) Say(text string) {
+	d.Ask(text)
+}
+
+// Ask works like Say, but also shows the answers on the last page.
+// The player selects the answer with Up/Down keys or gamepad buttons.
+//
+// Each answer occupies a single line. When answers do not fit
+// below the text, they are shown on a separate page.
+func (d *Dialog) Ask(text string, choices ...string) {
+	d.lineHeight = d.Sheet.LineHeight
+	if d.lineHeight <= 0 {
+		d.lineHeight = d.Sheet.Height
+	}
+	d.linesPerPage = 1
+	if d.lineHeight > 0 {
+		d.linesPerPage = max((d.Element.H-2*d.Padding)/d.lineHeight, 1)
+	}
+
+	lines := d.Sheet.Wrap(text, d.Element.W-2*d.Padding)
+	d.pages = d.pages[:0]
+	for len(lines) > 0 {
+		n := min(d.linesPerPage, len(lines))
+		d.pages = append(d.pages, lines[:n])
+		lines = lines[n:]
+	}
+	if len(d.pages) == 0 || len(choices) > 0 && len(d.pages[len(d.pages)-1])+len(choices) > d.linesPerPage {
+		d.pages = append(d.pages, nil)
+	}
+
+	d.choices = choices
+	d.selected = 0
+	d.active = true
+	d.openedFrame = pi.Frame
+	d.showPage(0)
+}
+
+// Active reports whether the dialogue is shown.
+func (d *Dialog) Active() bool {
+	return d.active
+}
+
+// Page returns the index of the current page.
+func (d *Dialog) Page() int {
+	return d.page
+}
+
+// Pages returns the number of pages.
+func (d *Dialog) Pages() int {
+	return len(d.pages)
+}
+
+// PageRevealed reports whether all characters on the current page are revealed.
+func (d *Dialog) PageRevealed() bool {
+	return d.revealed == d.pageLen
+}
+
+// Selected returns the index of the selected answer.
+func (d *Dialog) Selected() int {
+	return d.selected
+}
+
+// Select selects the answer with the given index.
+func (d *Dialog) Select(choice int) {
+	if choice >= 0 && choice < len(d.choices) {
+		d.selected = choice
+	}
+}
+
+// Confirm does the same as pressing the confirm key. It reveals the whole
+// page when it is being revealed, shows the next page or finishes the dialogue.
+func (d *Dialog) Confirm() {
+	if !d.active {
+		return
+	}
+
+	if !d.PageRevealed() {
+		d.revealed = d.pageLen
+		return
+	}
+
+	if d.page < len(d.pages)-1 {
+		d.showPage(d.page + 1)
+		return
+	}
+
+	d.active = false
+	choice := -1
+	if len(d.choices) > 0 {
+		choice = d.selected
+	}
+	d.target.Publish(Event{Type: EventFinished, Dialog: d, Choice: choice})
+}
+
+func (d *Dialog) showPage(page int) {
+	d.page = page
+	d.pageLen = 0
+	for _, line := range d.pages[page] {
+		d.pageLen += utf8.RuneCountInString(line)
+	}
+	d.revealed = 0
+	d.ticks = 0
+	if d.TicksPerChar <= 0 {
+		d.revealed = d.pageLen
+	}
+	d.target.Publish(Event{Type: EventPage, Dialog: d, Choice: -1})
+}
+
+func (d *Dialog) choicesVisible() bool {
+	return len(d.choices) > 0 && d.page == len(d.pages)-1 && d.PageRevealed()
+}
+
+func (d *Dialog) update(pigui.UpdateEvent) {
+	if !d.active {
+		return
+	}
+
+	if d.choicesVisible() {
+		if pikey.Duration(pikey.Up) == 1 || pipad.Duration(pipad.Top) == 1 {
+			d.selected = (d.selected - 1 + len(d.choices)) % len(d.choices)
+		}
+		if pikey.Duration(pikey.Down) == 1 || pipad.Duration(pipad.Bottom) == 1 {
+			d.selected = (d.selected + 1) % len(d.choices)
+		}
+	}
+
+	if d.confirmPressed() && pi.Frame != d.openedFrame {
+		d.Confirm()
+		return
+	}
+
+	d.reveal()
+}
+
+func (d *Dialog) confirmPressed() bool {
+	for _, k := range d.ConfirmKeys {
+		if pikey.Duration(k) == 1 {
+			return true
+		}
+	}
+	for _, b := range d.ConfirmButtons {
+		if pipad.Duration(b) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *Dialog) reveal() {
+	if d.PageRevealed() {
+		return
+	}
+	if d.TicksPerChar <= 0 {
+		d.revealed = d.pageLen
+		return
+	}
+
+	d.ticks++
+	if d.ticks < d.TicksPerChar {
+		return
+	}
+	d.ticks = 0
+	d.revealed++
+
+	if d.Sound != nil && d.charAt(d.revealed-1) != ' ' {
+		piaudio.Play(d.SoundChan, d.Sound, d.SoundPitch, d.SoundVolume)
+	}
+}
+
+// charAt returns the character with the given index on the current page.
+func (d *Dialog) charAt(index int) rune {
+	for _, line := range d.pages[d.page] {
+		for _, r := range line {
+			if index == 0 {
+				return r
+			}
+			index--
+		}
+	}
+	return 0
+}
+
+func (d *Dialog) draw(pigui.DrawEvent) {
+	if !d.active {
+		return
+	}
+
+	w, h := d.Element.W, d.Element.H
+	prevColor := pi.SetColor(d.Background)
+	defer pi.SetColor(prevColor)
+
+	pi.RectFill(0, 0, w-1, h-1)
+	if d.Border != d.Background {
+		pi.SetColor(d.Border)
+		pi.Rect(0, 0, w-1, h-1)
+	}
+
+	pi.SetColor(d.TextColor)
+	remaining := d.revealed
+	y := d.Padding
+	for _, line := range d.pages[d.page] {
+		if remaining <= 0 {
+			break
+		}
+		line = prefix(line, remaining)
+		remaining -= utf8.RuneCountInString(line)
+		d.Sheet.Print(line, d.Padding, y)
+		y += d.lineHeight
+	}
+
+	if !d.choicesVisible() {
+		return
+	}
+
+	// answers are aligned to the bottom of the box:
+	y = d.Padding + max(d.linesPerPage-len(d.choices), 0)*d.lineHeight
+	cursorWidth, _ := d.Sheet.Size(d.Cursor)
+	for i, choice := range d.choices {
+		if i == d.selected {
+			pi.SetColor(d.SelectedColor)
+			d.Sheet.Print(d.Cursor, d.Padding, y)
+		} else {
+			pi.SetColor(d.ChoiceColor)
+		}
+		d.Sheet.Print(choice, d.Padding+cursorWidth, y)
+		y += d.lineHeight
+	}
+}
+
+// prefix returns the first n characters of the text.
+func prefix(text string, n int) string {
+	for i := range text {
+		if n == 0 {
+			return text[:i]
+		}
+		n--
+	}
+	return text
+}
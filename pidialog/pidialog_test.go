@@ -0,0 +1,245 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pidialog_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elgopher/pi"
+	"github.com/elgopher/pi/piaudio"
+	"github.com/elgopher/pi/pidialog"
+	"github.com/elgopher/pi/pievent"
+	"github.com/elgopher/pi/pifont"
+	"github.com/elgopher/pi/pigui"
+	"github.com/elgopher/pi/pikey"
+)
+
+// newSheet creates a sheet where chars are 2x2 blocks of FgColor
+// and space is 1 pixel wide.
+func newSheet(chars string) pifont.Sheet {
+	block := pi.NewCanvas(2, 2)
+	block.Clear(1)
+	sheet := pifont.Sheet{
+		Chars:   map[rune]pi.Sprite{' ': pi.SpriteFrom(block, 0, 0, 0, 2)},
+		Advance: map[rune]int{' ': 1},
+		Height:  2,
+		FgColor: 1,
+	}
+	for _, char := range chars {
+		sheet.Chars[char] = pi.CanvasSprite(block)
+	}
+	return sheet
+}
+
+// newDialog creates a 5x4 dialog without padding and border.
+func newDialog() (*pigui.Element, *pidialog.Dialog) {
+	pi.SetScreenSize(5, 4)
+	pi.SetDrawTarget(pi.Screen())
+	pi.Cls()
+	root := pigui.New()
+	d := pidialog.Attach(root, newSheet("abcde>"), 0, 0, 5, 4)
+	d.Padding = 0
+	d.Border = d.Background
+	return root, d
+}
+
+func tick(root *pigui.Element) {
+	root.Update()
+	pi.Frame++
+}
+
+func press(root *pigui.Element, key pikey.Key) {
+	pikey.Target().Publish(pikey.Event{Type: pikey.EventDown, Key: key})
+	root.Update()
+	pikey.Target().Publish(pikey.Event{Type: pikey.EventUp, Key: key})
+	pi.Frame++
+}
+
+func subscribe(d *pidialog.Dialog) *[]pidialog.Event {
+	var events []pidialog.Event
+	d.Target().SubscribeAll(func(e pidialog.Event, _ pievent.Handler) {
+		events = append(events, e)
+	})
+	return &events
+}
+
+func TestDialog_Say(t *testing.T) {
+	t.Run("should split text into pages", func(t *testing.T) {
+		_, d := newDialog()
+		// when
+		d.Say("a b c d e")
+		// then
+		assert.True(t, d.Active())
+		assert.Equal(t, 2, d.Pages())
+		assert.Equal(t, 0, d.Page())
+		assert.False(t, d.PageRevealed())
+	})
+
+	t.Run("should reveal characters over time", func(t *testing.T) {
+		root, d := newDialog()
+		d.TicksPerChar = 2
+		d.Say("a b")
+		tick(root)
+		tick(root)
+		// when
+		root.Draw()
+		// then
+		assert.Equal(t, []pi.Color{
+			7, 7, 0, 0, 0,
+			7, 7, 0, 0, 0,
+			0, 0, 0, 0, 0,
+			0, 0, 0, 0, 0,
+		}, pi.Screen().Data())
+	})
+
+	t.Run("should show whole page immediately when TicksPerChar is 0", func(t *testing.T) {
+		_, d := newDialog()
+		d.TicksPerChar = 0
+		// when
+		d.Say("a b")
+		// then
+		assert.True(t, d.PageRevealed())
+	})
+
+	t.Run("should reveal whole page on confirm", func(t *testing.T) {
+		root, d := newDialog()
+		d.Say("a b c")
+		tick(root)
+		// when
+		press(root, pikey.Enter)
+		// then
+		assert.True(t, d.PageRevealed())
+		assert.Equal(t, 0, d.Page())
+		root.Draw()
+		assert.Equal(t, []pi.Color{
+			7, 7, 0, 7, 7,
+			7, 7, 0, 7, 7,
+			7, 7, 0, 0, 0,
+			7, 7, 0, 0, 0,
+		}, pi.Screen().Data())
+	})
+
+	t.Run("should show next page and finish", func(t *testing.T) {
+		root, d := newDialog()
+		d.TicksPerChar = 0
+		events := subscribe(d)
+		d.Say("a b c d e")
+		tick(root)
+		// when
+		press(root, pikey.Enter)
+		// then
+		assert.Equal(t, 1, d.Page())
+		// when
+		press(root, pikey.Space)
+		// then
+		assert.False(t, d.Active())
+		require.Len(t, *events, 3)
+		assert.Equal(t, pidialog.EventPage, (*events)[0].Type)
+		assert.Equal(t, pidialog.EventPage, (*events)[1].Type)
+		assert.Equal(t, pidialog.Event{Type: pidialog.EventFinished, Dialog: d, Choice: -1}, (*events)[2])
+	})
+
+	t.Run("should ignore confirm key pressed in the frame when dialogue started", func(t *testing.T) {
+		root, d := newDialog()
+		d.TicksPerChar = 0
+		events := subscribe(d)
+		// when
+		pikey.Target().Publish(pikey.Event{Type: pikey.EventDown, Key: pikey.Enter})
+		d.Say("a")
+		root.Update()
+		pikey.Target().Publish(pikey.Event{Type: pikey.EventUp, Key: pikey.Enter})
+		pi.Frame++
+		// then
+		assert.True(t, d.Active())
+		require.Len(t, *events, 1)
+		assert.Equal(t, pidialog.EventPage, (*events)[0].Type)
+	})
+
+	t.Run("should play sound for each revealed character except space", func(t *testing.T) {
+		backend := &fakeBackend{}
+		prevBackend := piaudio.Backend
+		piaudio.Backend = backend
+		defer func() {
+			piaudio.Backend = prevBackend
+		}()
+		root, d := newDialog()
+		d.TicksPerChar = 1
+		d.Sound = piaudio.NewSample([]int8{1}, 8000)
+		d.Say("a b")
+		// when
+		tick(root)
+		tick(root)
+		tick(root)
+		// then
+		assert.Equal(t, 2, backend.samplesSet)
+	})
+}
+
+func TestDialog_Ask(t *testing.T) {
+	t.Run("should select answer", func(t *testing.T) {
+		root, d := newDialog()
+		d.TicksPerChar = 0
+		events := subscribe(d)
+		d.Ask("", "a", "b")
+		// when
+		press(root, pikey.Down)
+		press(root, pikey.Enter)
+		// then
+		assert.False(t, d.Active())
+		require.NotEmpty(t, *events)
+		assert.Equal(t, pidialog.Event{Type: pidialog.EventFinished, Dialog: d, Choice: 1}, (*events)[len(*events)-1])
+	})
+
+	t.Run("should wrap selection", func(t *testing.T) {
+		root, d := newDialog()
+		d.TicksPerChar = 0
+		d.Ask("", "a", "b", "c")
+		// when
+		press(root, pikey.Up)
+		// then
+		assert.Equal(t, 2, d.Selected())
+	})
+
+	t.Run("should show answers on a separate page when they do not fit", func(t *testing.T) {
+		_, d := newDialog()
+		// when
+		d.Ask("a", "b", "c")
+		// then
+		assert.Equal(t, 2, d.Pages())
+	})
+
+	t.Run("should draw answers with cursor", func(t *testing.T) {
+		root, d := newDialog()
+		d.TicksPerChar = 0
+		d.Cursor = ""
+		d.Ask("", "a", "b")
+		d.Select(1)
+		// when
+		root.Draw()
+		// then
+		assert.Equal(t, []pi.Color{
+			6, 6, 0, 0, 0,
+			6, 6, 0, 0, 0,
+			10, 10, 0, 0, 0,
+			10, 10, 0, 0, 0,
+		}, pi.Screen().Data())
+	})
+}
+
+type fakeBackend struct {
+	samplesSet int
+}
+
+func (f *fakeBackend) LoadSample(*piaudio.Sample)   {}
+func (f *fakeBackend) UnloadSample(*piaudio.Sample) {}
+func (f *fakeBackend) SetSample(piaudio.Chan, *piaudio.Sample, int, float64) {
+	f.samplesSet++
+}
+func (f *fakeBackend) SetLoop(piaudio.Chan, int, int, piaudio.LoopType, float64) {}
+func (f *fakeBackend) SetPitch(piaudio.Chan, float64, float64)                   {}
+func (f *fakeBackend) SetVolume(piaudio.Chan, float64, float64)                  {}
+func (f *fakeBackend) ClearChan(piaudio.Chan, float64)                           {}
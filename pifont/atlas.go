@@ -0,0 +1,85 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pifont
+
+import "github.com/elgopher/pi"
+
+// glyph is a character loaded from a font file, before it is put into a Sheet.
+type glyph struct {
+	char    rune
+	advance int // horizontal distance to the next character
+	// bounding box of the glyph bitmap relative to the top-left corner
+	// of the character cell:
+	x, y, w, h int
+	pixel      func(x, y int) bool // reports whether bitmap pixel is set
+}
+
+const atlasMaxWidth = 512
+
+// newSheet draws glyphs into a new canvas, each in a separate cell
+// with the line height, and creates a Sheet with FgColor 1 and BgColor 0.
+//
+// Because glyphs are positioned inside cells, all glyphs share the same
+// baseline. The cell width is the union of the glyph bitmap and its advance,
+// so no pixels are lost for glyphs overhanging their advance. Glyphs with
+// negative x offset start at the left edge of the cell and the offset
+// is stored in Sheet.Bearing, so they are still drawn to the left
+// of the current position.
+//
+// When the cell width differs from the glyph advance, the advance
+// is stored in Sheet.Advance.
+func newSheet(lineHeight int, glyphs []glyph) Sheet {
+	// layout cells in rows:
+	type cell struct{ x, y, w, originX int }
+	cells := make([]cell, len(glyphs))
+	x, y, width := 0, 0, 0
+	for i, g := range glyphs {
+		originX := max(-g.x, 0)
+		w := max(g.advance, g.x+g.w) + originX
+		if x+w > atlasMaxWidth && x > 0 {
+			x = 0
+			y += lineHeight
+		}
+		cells[i] = cell{x: x, y: y, w: w, originX: originX}
+		x += w
+		width = max(width, x)
+	}
+
+	canvas := pi.NewCanvas(width, y+lineHeight)
+
+	sheet := Sheet{
+		Chars:   make(map[rune]pi.Sprite, len(glyphs)),
+		Height:  lineHeight,
+		FgColor: 1,
+		BgColor: 0,
+	}
+
+	for i, g := range glyphs {
+		c := cells[i]
+		cellArea := pi.IntArea{X: c.x, Y: c.y, W: c.w, H: lineHeight}
+		for by := 0; by < g.h; by++ {
+			for bx := 0; bx < g.w; bx++ {
+				px, py := c.x+c.originX+g.x+bx, c.y+g.y+by
+				if cellArea.Contains(px, py) && g.pixel(bx, by) {
+					canvas.Set(px, py, 1)
+				}
+			}
+		}
+		sheet.Chars[g.char] = pi.Sprite{Area: cellArea, Source: canvas}
+		if c.w != g.advance {
+			if sheet.Advance == nil {
+				sheet.Advance = map[rune]int{}
+			}
+			sheet.Advance[g.char] = g.advance
+		}
+		if c.originX > 0 {
+			if sheet.Bearing == nil {
+				sheet.Bearing = map[rune]int{}
+			}
+			sheet.Bearing[g.char] = -c.originX
+		}
+	}
+
+	return sheet
+}
@@ -0,0 +1,172 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pifont
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DecodeBDF decodes a font in the Glyph Bitmap Distribution Format (.bdf).
+//
+// Each character sprite covers both its bitmap and its advance (taken from DWIDTH),
+// so glyphs overhanging the advance are not cut. When the sprite width
+// differs from the advance, the advance is stored in Sheet.Advance.
+// Glyphs starting left of the current position have their offset stored
+// in Sheet.Bearing. All characters are aligned to the same baseline.
+// Characters with negative or unknown encoding are skipped.
+//
+// The returned Sheet has FgColor 1 and BgColor 0.
+func DecodeBDF(bdfFile []byte) Sheet {
+	s, err := DecodeBDFOrErr(bdfFile)
+	if err != nil {
+		panic("DecodeBDF failed: " + err.Error())
+	}
+	return s
+}
+
+// DecodeBDFOrErr works like DecodeBDF but returns an error
+// if the file is invalid.
+func DecodeBDFOrErr(bdfFile []byte) (Sheet, error) {
+	var (
+		glyphs             []glyph
+		ascent, descent    int
+		boundingH, offsetY int
+		current            *bdfGlyph
+		bitmapRow          = -1
+		chars              []bdfGlyph
+	)
+
+	scanner := bufio.NewScanner(bytes.NewReader(bdfFile))
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		if bitmapRow >= 0 {
+			if fields[0] == "ENDCHAR" {
+				chars = append(chars, *current)
+				current = nil
+				bitmapRow = -1
+				continue
+			}
+			row, err := hex.DecodeString(fields[0])
+			if err != nil {
+				return Sheet{}, fmt.Errorf("line %d: invalid bitmap row %q", lineNo, fields[0])
+			}
+			current.rows = append(current.rows, row)
+			bitmapRow++
+			continue
+		}
+
+		ints, err := atoiAll(fields[1:])
+		if err != nil {
+			switch fields[0] {
+			case "FONTBOUNDINGBOX", "FONT_ASCENT", "FONT_DESCENT", "ENCODING", "DWIDTH", "BBX":
+				return Sheet{}, fmt.Errorf("line %d: %w", lineNo, err)
+			}
+		}
+
+		switch fields[0] {
+		case "FONTBOUNDINGBOX":
+			if len(ints) < 4 {
+				return Sheet{}, fmt.Errorf("line %d: invalid FONTBOUNDINGBOX", lineNo)
+			}
+			boundingH, offsetY = ints[1], ints[3]
+		case "FONT_ASCENT":
+			if len(ints) > 0 {
+				ascent = ints[0]
+			}
+		case "FONT_DESCENT":
+			if len(ints) > 0 {
+				descent = ints[0]
+			}
+		case "STARTCHAR":
+			current = &bdfGlyph{encoding: -1}
+		case "ENCODING":
+			if current != nil && len(ints) > 0 {
+				current.encoding = ints[0]
+			}
+		case "DWIDTH":
+			if current != nil && len(ints) > 0 {
+				current.advance = ints[0]
+			}
+		case "BBX":
+			if current != nil && len(ints) >= 4 {
+				current.w, current.h, current.xoff, current.yoff = ints[0], ints[1], ints[2], ints[3]
+			}
+		case "BITMAP":
+			if current == nil {
+				return Sheet{}, fmt.Errorf("line %d: BITMAP outside of character", lineNo)
+			}
+			bitmapRow = 0
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return Sheet{}, err //nolint:wrapcheck
+	}
+	if current != nil {
+		return Sheet{}, errors.New("missing ENDCHAR")
+	}
+
+	if ascent == 0 && descent == 0 {
+		// properties are optional, so use the font bounding box instead:
+		ascent = boundingH + offsetY
+		descent = -offsetY
+	}
+	lineHeight := ascent + descent
+	if lineHeight <= 0 {
+		return Sheet{}, errors.New("invalid font height")
+	}
+
+	for _, c := range chars {
+		if c.encoding < 0 {
+			continue
+		}
+		rows := c.rows
+		glyphs = append(glyphs, glyph{
+			char:    rune(c.encoding),
+			advance: c.advance,
+			x:       c.xoff,
+			y:       ascent - c.yoff - c.h,
+			w:       c.w,
+			h:       c.h,
+			pixel: func(x, y int) bool {
+				if y >= len(rows) || x/8 >= len(rows[y]) {
+					return false
+				}
+				return rows[y][x/8]&(0x80>>(x%8)) != 0
+			},
+		})
+	}
+
+	return newSheet(lineHeight, glyphs), nil
+}
+
+type bdfGlyph struct {
+	encoding         int
+	advance          int
+	w, h, xoff, yoff int
+	rows             [][]byte
+}
+
+func atoiAll(fields []string) ([]int, error) {
+	ints := make([]int, 0, len(fields))
+	for _, f := range fields {
+		i, err := strconv.Atoi(f)
+		if err != nil {
+			return ints, fmt.Errorf("invalid number %q", f)
+		}
+		ints = append(ints, i)
+	}
+	return ints, nil
+}
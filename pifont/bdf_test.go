@@ -0,0 +1,200 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pifont_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elgopher/pi"
+	"github.com/elgopher/pi/pifont"
+)
+
+const bdfFont = `STARTFONT 2.1
+FONT -test-font
+SIZE 5 75 75
+FONTBOUNDINGBOX 3 5 0 -1
+STARTPROPERTIES 2
+FONT_ASCENT 4
+FONT_DESCENT 1
+ENDPROPERTIES
+CHARS 3
+STARTCHAR A
+ENCODING 65
+SWIDTH 500 0
+DWIDTH 4 0
+BBX 3 4 0 0
+BITMAP
+40
+A0
+E0
+A0
+ENDCHAR
+STARTCHAR lslash
+ENCODING 322
+SWIDTH 500 0
+DWIDTH 3 0
+BBX 2 3 1 0
+BITMAP
+80
+C0
+80
+ENDCHAR
+STARTCHAR comma
+ENCODING 44
+SWIDTH 500 0
+DWIDTH 2 0
+BBX 1 2 0 -1
+BITMAP
+80
+80
+ENDCHAR
+ENDFONT
+`
+
+const bdfOverhangingFont = `STARTFONT 2.1
+FONTBOUNDINGBOX 3 5 -1 -1
+STARTPROPERTIES 2
+FONT_ASCENT 4
+FONT_DESCENT 1
+ENDPROPERTIES
+CHARS 2
+STARTCHAR j
+ENCODING 106
+DWIDTH 2 0
+BBX 3 4 -1 -1
+BITMAP
+20
+20
+A0
+40
+ENDCHAR
+STARTCHAR f
+ENCODING 102
+DWIDTH 2 0
+BBX 3 1 0 2
+BITMAP
+E0
+ENDCHAR
+ENDFONT
+`
+
+// spriteRows returns sprite pixels as strings, with '#' for color 1.
+func spriteRows(sprite pi.Sprite) []string {
+	var rows []string
+	for y := 0; y < sprite.H; y++ {
+		row := ""
+		for x := 0; x < sprite.W; x++ {
+			if sprite.Source.Get(sprite.X+x, sprite.Y+y) == 1 {
+				row += "#"
+			} else {
+				row += "."
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func TestDecodeBDF(t *testing.T) {
+	t.Run("should decode glyphs aligned to baseline", func(t *testing.T) {
+		sheet, err := pifont.DecodeBDFOrErr([]byte(bdfFont))
+		require.NoError(t, err)
+		assert.Equal(t, 5, sheet.Height)
+		assert.Equal(t, pi.Color(1), sheet.FgColor)
+		assert.Equal(t, pi.Color(0), sheet.BgColor)
+		require.Len(t, sheet.Chars, 3)
+		assert.Equal(t, []string{
+			".#..",
+			"#.#.",
+			"###.",
+			"#.#.",
+			"....",
+		}, spriteRows(sheet.Chars['A']))
+		assert.Equal(t, []string{
+			"...",
+			".#.",
+			".##",
+			".#.",
+			"...",
+		}, spriteRows(sheet.Chars['ł']))
+		assert.Equal(t, []string{
+			"..",
+			"..",
+			"..",
+			"#.",
+			"#.",
+		}, spriteRows(sheet.Chars[',']))
+	})
+
+	t.Run("should keep pixels outside the advance", func(t *testing.T) {
+		sheet, err := pifont.DecodeBDFOrErr([]byte(bdfOverhangingFont))
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"...",
+			"..#",
+			"..#",
+			"#.#",
+			".#.",
+		}, spriteRows(sheet.Chars['j']), "negative x offset")
+		assert.Equal(t, []string{
+			"...",
+			"###",
+			"...",
+			"...",
+			"...",
+		}, spriteRows(sheet.Chars['f']), "glyph wider than advance")
+	})
+
+	t.Run("should set advance different than sprite width", func(t *testing.T) {
+		sheet, err := pifont.DecodeBDFOrErr([]byte(bdfOverhangingFont))
+		require.NoError(t, err)
+		assert.Equal(t, map[rune]int{'j': 2, 'f': 2}, sheet.Advance)
+		width, _ := sheet.Size("jf")
+		assert.Equal(t, 4, width)
+	})
+
+	t.Run("should draw glyph with negative x offset left of the current position", func(t *testing.T) {
+		sheet, err := pifont.DecodeBDFOrErr([]byte(bdfOverhangingFont))
+		require.NoError(t, err)
+		assert.Equal(t, map[rune]int{'j': -1}, sheet.Bearing)
+		canvas := pi.NewCanvas(5, 5)
+		pi.SetDrawTarget(canvas)
+		// when
+		sheet.PrintOriginal("fj", 0, 0)
+		// then
+		assert.Equal(t, []string{
+			".....",
+			"####.",
+			"...#.",
+			".#.#.",
+			"..#..",
+		}, spriteRows(pi.SpriteFrom(canvas, 0, 0, 5, 5)))
+	})
+
+	t.Run("should not set advance and bearing for glyphs matching their cells", func(t *testing.T) {
+		sheet, err := pifont.DecodeBDFOrErr([]byte(bdfFont))
+		require.NoError(t, err)
+		assert.Empty(t, sheet.Advance)
+		assert.Empty(t, sheet.Bearing)
+	})
+
+	t.Run("should return error for invalid file", func(t *testing.T) {
+		_, err := pifont.DecodeBDFOrErr([]byte("STARTFONT 2.1\nFONTBOUNDINGBOX a b c d\n"))
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when character is not finished", func(t *testing.T) {
+		_, err := pifont.DecodeBDFOrErr([]byte("STARTFONT 2.1\nFONTBOUNDINGBOX 3 5 0 -1\nSTARTCHAR A\nENCODING 65\n"))
+		assert.Error(t, err)
+	})
+
+	t.Run("should panic for invalid file", func(t *testing.T) {
+		assert.Panics(t, func() {
+			pifont.DecodeBDF([]byte("STARTFONT 2.1\n"))
+		})
+	})
+}
@@ -0,0 +1,151 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pifont
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/png"
+	"strconv"
+	"strings"
+)
+
+// DecodeBMFont decodes an AngelCode BMFont in text format (.fnt)
+// together with its page images (PNG files), in the order of page ids.
+//
+// A page pixel is part of a glyph when it is opaque and bright
+// (alpha and at least one of RGB components are >= 128), so both white
+// glyphs on transparent background and white glyphs on black background
+// are supported.
+//
+// Each character sprite covers both its bitmap and its advance (taken from xadvance),
+// so glyphs overhanging the advance are not cut. When the sprite width
+// differs from the advance, the advance is stored in Sheet.Advance.
+// Glyphs starting left of the current position have their offset stored
+// in Sheet.Bearing. All characters are aligned to the same baseline.
+// Kerning pairs are stored in Sheet.Kerning.
+//
+// The returned Sheet has FgColor 1 and BgColor 0.
+func DecodeBMFont(fntFile []byte, pages ...[]byte) Sheet {
+	s, err := DecodeBMFontOrErr(fntFile, pages...)
+	if err != nil {
+		panic("DecodeBMFont failed: " + err.Error())
+	}
+	return s
+}
+
+// DecodeBMFontOrErr works like DecodeBMFont but returns an error
+// if the files are invalid.
+func DecodeBMFontOrErr(fntFile []byte, pages ...[]byte) (Sheet, error) {
+	images := make([]image.Image, len(pages))
+	for i, page := range pages {
+		img, err := png.Decode(bytes.NewReader(page))
+		if err != nil {
+			return Sheet{}, fmt.Errorf("page %d: PNG decoding failed: %w", i, err)
+		}
+		images[i] = img
+	}
+
+	lineHeight := 0
+	var glyphs []glyph
+	var kerning map[KerningPair]int
+
+	scanner := bufio.NewScanner(bytes.NewReader(fntFile))
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		tag, attrs := parseBMFontLine(scanner.Text())
+
+		switch tag {
+		case "common":
+			var err error
+			lineHeight, err = strconv.Atoi(attrs["lineHeight"])
+			if err != nil {
+				return Sheet{}, fmt.Errorf("line %d: invalid lineHeight", lineNo)
+			}
+		case "char":
+			values := map[string]int{}
+			for _, key := range []string{"id", "x", "y", "width", "height", "xoffset", "yoffset", "xadvance", "page"} {
+				v, err := strconv.Atoi(attrs[key])
+				if err != nil {
+					return Sheet{}, fmt.Errorf("line %d: invalid %s", lineNo, key)
+				}
+				values[key] = v
+			}
+			page := values["page"]
+			if page < 0 || page >= len(images) {
+				return Sheet{}, fmt.Errorf("line %d: missing page %d", lineNo, page)
+			}
+			img := images[page]
+			srcX, srcY := values["x"], values["y"]
+			glyphs = append(glyphs, glyph{
+				char:    rune(values["id"]),
+				advance: values["xadvance"],
+				x:       values["xoffset"],
+				y:       values["yoffset"],
+				w:       values["width"],
+				h:       values["height"],
+				pixel: func(x, y int) bool {
+					r, g, b, a := img.At(img.Bounds().Min.X+srcX+x, img.Bounds().Min.Y+srcY+y).RGBA()
+					return a >= 0x8000 && max(r, g, b) >= 0x8000
+				},
+			})
+		case "kerning":
+			var values [3]int
+			for i, key := range []string{"first", "second", "amount"} {
+				v, err := strconv.Atoi(attrs[key])
+				if err != nil {
+					return Sheet{}, fmt.Errorf("line %d: invalid %s", lineNo, key)
+				}
+				values[i] = v
+			}
+			if kerning == nil {
+				kerning = map[KerningPair]int{}
+			}
+			kerning[KerningPair{First: rune(values[0]), Second: rune(values[1])}] = values[2]
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return Sheet{}, err //nolint:wrapcheck
+	}
+
+	if lineHeight <= 0 {
+		return Sheet{}, errors.New("missing common line with lineHeight")
+	}
+
+	sheet := newSheet(lineHeight, glyphs)
+	sheet.Kerning = kerning
+	return sheet, nil
+}
+
+// parseBMFontLine parses a line like `char id=65 x=0 y=0` or `info face="Some Font"`.
+func parseBMFontLine(line string) (tag string, attrs map[string]string) {
+	line = strings.TrimSpace(line)
+	tag, rest, _ := strings.Cut(line, " ")
+	attrs = map[string]string{}
+
+	for rest = strings.TrimSpace(rest); rest != ""; rest = strings.TrimSpace(rest) {
+		key, value, found := strings.Cut(rest, "=")
+		if !found {
+			break
+		}
+		key = strings.TrimSpace(key)
+		if strings.HasPrefix(value, `"`) {
+			end := strings.Index(value[1:], `"`)
+			if end < 0 {
+				end = len(value) - 1
+			}
+			attrs[key] = value[1 : end+1]
+			rest = value[min(end+2, len(value)):]
+		} else {
+			value, rest, _ = strings.Cut(value, " ")
+			attrs[key] = value
+		}
+	}
+
+	return tag, attrs
+}
@@ -0,0 +1,100 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pifont_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elgopher/pi/pifont"
+)
+
+const bmFont = `info face="Test Font" size=5 bold=0 italic=0 charset="" unicode=1 stretchH=100 smooth=0 aa=1 padding=0,0,0,0 spacing=1,1
+common lineHeight=5 base=4 scaleW=8 scaleH=4 pages=1 packed=0
+page id=0 file="font_0.png"
+chars count=2
+char id=65   x=0  y=0  width=3 height=4 xoffset=0 yoffset=0 xadvance=4 page=0 chnl=15
+char id=223  x=4  y=0  width=2 height=3 xoffset=1 yoffset=1 xadvance=3 page=0 chnl=15
+kernings count=1
+kerning first=65 second=223 amount=-1
+`
+
+// bmFontPage creates a page with white glyphs on transparent background.
+func bmFontPage() []byte {
+	rows := []string{
+		".#..##..",
+		"#.#.#...",
+		"###.##..",
+		"#.#.....",
+	}
+	img := image.NewNRGBA(image.Rect(0, 0, 8, 4))
+	for y, row := range rows {
+		for x, c := range row {
+			if c == '#' {
+				img.Set(x, y, color.White)
+			} else {
+				img.Set(x, y, color.NRGBA{R: 255, G: 255, B: 255, A: 0})
+			}
+		}
+	}
+	var b bytes.Buffer
+	_ = png.Encode(&b, img)
+	return b.Bytes()
+}
+
+func TestDecodeBMFont(t *testing.T) {
+	t.Run("should decode glyphs aligned to baseline", func(t *testing.T) {
+		sheet, err := pifont.DecodeBMFontOrErr([]byte(bmFont), bmFontPage())
+		require.NoError(t, err)
+		assert.Equal(t, 5, sheet.Height)
+		require.Len(t, sheet.Chars, 2)
+		assert.Equal(t, []string{
+			".#..",
+			"#.#.",
+			"###.",
+			"#.#.",
+			"....",
+		}, spriteRows(sheet.Chars['A']))
+		assert.Equal(t, []string{
+			"...",
+			".##",
+			".#.",
+			".##",
+			"...",
+		}, spriteRows(sheet.Chars['ß']))
+	})
+
+	t.Run("should decode kerning", func(t *testing.T) {
+		sheet, err := pifont.DecodeBMFontOrErr([]byte(bmFont), bmFontPage())
+		require.NoError(t, err)
+		assert.Equal(t, map[pifont.KerningPair]int{{First: 'A', Second: 'ß'}: -1}, sheet.Kerning)
+	})
+
+	t.Run("should return error when page is missing", func(t *testing.T) {
+		_, err := pifont.DecodeBMFontOrErr([]byte(bmFont))
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when page is not PNG", func(t *testing.T) {
+		_, err := pifont.DecodeBMFontOrErr([]byte(bmFont), []byte("invalid"))
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when lineHeight is missing", func(t *testing.T) {
+		_, err := pifont.DecodeBMFontOrErr([]byte("info face=\"x\"\n"))
+		assert.Error(t, err)
+	})
+
+	t.Run("should panic for invalid file", func(t *testing.T) {
+		assert.Panics(t, func() {
+			pifont.DecodeBMFont(nil)
+		})
+	})
+}
@@ -0,0 +1,202 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pifont
+
+import (
+	"unicode/utf8"
+
+	"github.com/elgopher/pi"
+)
+
+// Align specifies how text is aligned inside a box.
+//
+// Horizontal and vertical alignment can be combined, for example
+// AlignCenter | AlignMiddle. The zero value aligns text to the top-left corner.
+type Align uint8
+
+const (
+	AlignLeft   Align = 0
+	AlignCenter Align = 1
+	AlignRight  Align = 2
+
+	AlignTop    Align = 0
+	AlignMiddle Align = 4
+	AlignBottom Align = 8
+
+	horizontalAlign = AlignCenter | AlignRight
+	verticalAlign   = AlignMiddle | AlignBottom
+)
+
+// PrintBox draws text inside the area using the current draw color.
+//
+// Text is wrapped at spaces so that lines fit within the area width.
+// Words longer than the area width are split. Newline characters
+// always start a new line.
+//
+// The text is clipped to the area. Text aligned to the middle, which does not fit
+// in the area height, starts at the top of the area, so it is cut from the bottom.
+// PrintBox returns the number of lines and whether the text did not fit
+// in the area height (overflow).
+func (s Sheet) PrintBox(text string, area pi.IntArea, align Align) (lines int, overflow bool) {
+	wrapped := s.wrap(text, area.W)
+	lines = len(wrapped)
+	textHeight := lines * s.lineHeightOrDefault()
+	overflow = textHeight > area.H
+
+	y := area.Y
+	switch align & verticalAlign {
+	case AlignMiddle:
+		y += max((area.H-textHeight)/2, 0)
+	case AlignBottom:
+		y += area.H - textHeight
+	}
+
+	clipArea := area.MovedBy(-pi.Camera.X, -pi.Camera.Y).Intersect(pi.Clip())
+	prevClip := pi.SetClip(clipArea)
+
+	for _, line := range wrapped {
+		x := area.X
+		switch align & horizontalAlign {
+		case AlignCenter:
+			x += (area.W - line.width) / 2
+		case AlignRight:
+			x += area.W - line.width
+		}
+		s.Print(text[line.start:line.end], x, y)
+		y += s.lineHeightOrDefault()
+	}
+
+	pi.SetClip(prevClip)
+
+	return lines, overflow
+}
+
+// SizeBox returns the dimensions of the text wrapped to maxWidth,
+// as drawn by PrintBox, without rendering it.
+//
+// width is the width of the longest line and height is the number of lines
+// multiplied by the line height.
+func (s Sheet) SizeBox(text string, maxWidth int) (width, height, lines int) {
+	wrapped := s.wrap(text, maxWidth)
+	for _, line := range wrapped {
+		width = max(width, line.width)
+	}
+	return width, len(wrapped) * s.lineHeightOrDefault(), len(wrapped)
+}
+
+// Wrap splits the text into lines which fit within maxWidth,
+// in the same way as PrintBox does.
+func (s Sheet) Wrap(text string, maxWidth int) []string {
+	wrapped := s.wrap(text, maxWidth)
+	lines := make([]string, len(wrapped))
+	for i, line := range wrapped {
+		lines[i] = text[line.start:line.end]
+	}
+	return lines
+}
+
+type textLine struct {
+	start, end int // byte offsets in the text
+	width      int
+}
+
+var wrappedLines []textLine // reused between calls to avoid allocations
+
+// wrap splits text into lines not wider than maxWidth.
+// Empty text has no lines.
+//
+// The returned slice is valid until the next call.
+func (s Sheet) wrap(text string, maxWidth int) []textLine {
+	lines := wrappedLines[:0]
+	if text == "" {
+		return lines
+	}
+
+	start := 0
+	for {
+		end := start
+		for end < len(text) && text[end] != '\n' {
+			end++
+		}
+		lines = s.wrapParagraph(text, start, end, maxWidth, lines)
+		if end == len(text) {
+			break
+		}
+		start = end + 1
+	}
+
+	wrappedLines = lines
+	return lines
+}
+
+func (s Sheet) wrapParagraph(text string, start, end, maxWidth int, lines []textLine) []textLine {
+	line := textLine{start: start, end: start}
+	firstLine := true
+	empty := true
+
+	for i := start; i < end; {
+		for i < end && text[i] == ' ' {
+			i++
+		}
+		if i == end {
+			break // trailing spaces are not printed
+		}
+
+		wordStart := i
+		for i < end && text[i] != ' ' {
+			i++
+		}
+
+		// widths are measured for the whole line, because of letter spacing and kerning:
+		if empty && !firstLine {
+			line.start = wordStart // spaces at the beginning of wrapped line are not printed
+		}
+		width := s.textWidth(text[line.start:i])
+
+		if !empty && width > maxWidth {
+			lines = append(lines, line)
+			firstLine = false
+			line = textLine{start: wordStart, end: wordStart}
+			width = s.textWidth(text[wordStart:i])
+		}
+
+		if width > maxWidth { // the word does not fit in a single line
+			for j := wordStart; j < i; {
+				_, size := utf8.DecodeRuneInString(text[j:])
+				lineWidth := s.textWidth(text[line.start : j+size])
+				if line.end > line.start && lineWidth > maxWidth {
+					lines = append(lines, line)
+					firstLine = false
+					line = textLine{start: j, end: j}
+					lineWidth = s.textWidth(text[j : j+size])
+				}
+				j += size
+				line.end = j
+				line.width = lineWidth
+			}
+			empty = false
+			continue
+		}
+
+		line.end = i
+		line.width = width
+		empty = false
+	}
+
+	return append(lines, line)
+}
+
+// textWidth returns the width of a single line of text.
+func (s Sheet) textWidth(text string) int {
+	width := 0
+	var prev rune
+	for _, r := range text {
+		if prev != 0 {
+			width += s.spacing(prev, r)
+		}
+		width += s.advance(r)
+		prev = r
+	}
+	return width
+}
@@ -0,0 +1,172 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pifont_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elgopher/pi"
+	"github.com/elgopher/pi/pifont"
+)
+
+// newBlockSheet creates a sheet where letters are 2x2 blocks of FgColor
+// and space is 1 pixel wide.
+func newBlockSheet() pifont.Sheet {
+	canvas := pi.NewCanvas(3, 2)
+	canvas.SetAll(
+		1, 1, 0,
+		1, 1, 0,
+	)
+	letter := pi.SpriteFrom(canvas, 0, 0, 2, 2)
+	return pifont.Sheet{
+		Chars: map[rune]pi.Sprite{
+			'a': letter,
+			'b': letter,
+			'c': letter,
+			' ': pi.SpriteFrom(canvas, 2, 0, 1, 2),
+		},
+		Height:  2,
+		FgColor: 1,
+		BgColor: 0,
+	}
+}
+
+func TestSheet_SizeBox(t *testing.T) {
+	sheet := newBlockSheet()
+
+	tests := map[string]struct {
+		text           string
+		maxWidth       int
+		expectedWidth  int
+		expectedHeight int
+		expectedLines  int
+	}{
+		"empty": {
+			text: "", maxWidth: 10, expectedWidth: 0, expectedHeight: 0, expectedLines: 0,
+		},
+		"single line": {
+			text: "ab a", maxWidth: 10, expectedWidth: 7, expectedHeight: 2, expectedLines: 1,
+		},
+		"wrapped at space": {
+			text: "ab a", maxWidth: 6, expectedWidth: 4, expectedHeight: 4, expectedLines: 2,
+		},
+		"new line": {
+			text: "a\nb\n", maxWidth: 10, expectedWidth: 2, expectedHeight: 6, expectedLines: 3,
+		},
+		"long word split": {
+			text: "abcab", maxWidth: 4, expectedWidth: 4, expectedHeight: 6, expectedLines: 3,
+		},
+		"trailing spaces ignored": {
+			text: "a   ", maxWidth: 2, expectedWidth: 2, expectedHeight: 2, expectedLines: 1,
+		},
+		"indentation kept": {
+			text: "  a", maxWidth: 10, expectedWidth: 4, expectedHeight: 2, expectedLines: 1,
+		},
+		"multiple spaces between words": {
+			text: "a  b", maxWidth: 6, expectedWidth: 6, expectedHeight: 2, expectedLines: 1,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			width, height, lines := sheet.SizeBox(test.text, test.maxWidth)
+			assert.Equal(t, test.expectedWidth, width)
+			assert.Equal(t, test.expectedHeight, height)
+			assert.Equal(t, test.expectedLines, lines)
+		})
+	}
+}
+
+func TestSheet_PrintBox(t *testing.T) {
+	sheet := newBlockSheet()
+
+	print := func(text string, area pi.IntArea, align pifont.Align) (pi.Canvas, int, bool) {
+		pi.SetScreenSize(6, 4)
+		pi.SetDrawTarget(pi.Screen())
+		pi.Cls()
+		pi.SetColor(7)
+		lines, overflow := sheet.PrintBox(text, area, align)
+		return pi.Screen(), lines, overflow
+	}
+
+	t.Run("should wrap text", func(t *testing.T) {
+		screen, lines, overflow := print("a b c", pi.IntArea{W: 6, H: 4}, pifont.AlignLeft)
+		assert.Equal(t, 2, lines)
+		assert.False(t, overflow)
+		assert.Equal(t, []pi.Color{
+			7, 7, 0, 7, 7, 0,
+			7, 7, 0, 7, 7, 0,
+			7, 7, 0, 0, 0, 0,
+			7, 7, 0, 0, 0, 0,
+		}, screen.Data())
+	})
+
+	t.Run("should align to the center and middle", func(t *testing.T) {
+		screen, _, _ := print("a", pi.IntArea{W: 6, H: 4}, pifont.AlignCenter|pifont.AlignMiddle)
+		assert.Equal(t, []pi.Color{
+			0, 0, 0, 0, 0, 0,
+			0, 0, 7, 7, 0, 0,
+			0, 0, 7, 7, 0, 0,
+			0, 0, 0, 0, 0, 0,
+		}, screen.Data())
+	})
+
+	t.Run("should align to the right and bottom", func(t *testing.T) {
+		screen, _, _ := print("a", pi.IntArea{W: 6, H: 4}, pifont.AlignRight|pifont.AlignBottom)
+		assert.Equal(t, []pi.Color{
+			0, 0, 0, 0, 0, 0,
+			0, 0, 0, 0, 0, 0,
+			0, 0, 0, 0, 7, 7,
+			0, 0, 0, 0, 7, 7,
+		}, screen.Data())
+	})
+
+	t.Run("should clip text to the area and report overflow", func(t *testing.T) {
+		screen, lines, overflow := print("a b", pi.IntArea{X: 1, Y: 1, W: 3, H: 3}, pifont.AlignLeft)
+		assert.Equal(t, 2, lines)
+		assert.True(t, overflow)
+		assert.Equal(t, []pi.Color{
+			0, 0, 0, 0, 0, 0,
+			0, 7, 7, 0, 0, 0,
+			0, 7, 7, 0, 0, 0,
+			0, 7, 7, 0, 0, 0,
+		}, screen.Data())
+	})
+
+	t.Run("should cut overflowing text aligned to the middle from the bottom", func(t *testing.T) {
+		screen, _, overflow := print("a\nab\nab", pi.IntArea{W: 6, H: 3}, pifont.AlignMiddle)
+		assert.True(t, overflow)
+		assert.Equal(t, []pi.Color{
+			7, 7, 0, 0, 0, 0,
+			7, 7, 0, 0, 0, 0,
+			7, 7, 7, 7, 0, 0,
+			0, 0, 0, 0, 0, 0,
+		}, screen.Data())
+	})
+
+	t.Run("should not print empty text", func(t *testing.T) {
+		screen, lines, overflow := print("", pi.IntArea{W: 6, H: 4}, pifont.AlignLeft)
+		assert.Equal(t, 0, lines)
+		assert.False(t, overflow)
+		assert.Equal(t, make([]pi.Color, 24), screen.Data())
+	})
+
+	t.Run("should restore clip", func(t *testing.T) {
+		pi.SetScreenSize(6, 4)
+		pi.SetDrawTarget(pi.Screen())
+		pi.SetClip(pi.IntArea{X: 1, Y: 1, W: 2, H: 2})
+		sheet.PrintBox("a", pi.IntArea{W: 6, H: 4}, pifont.AlignLeft)
+		assert.Equal(t, pi.IntArea{X: 1, Y: 1, W: 2, H: 2}, pi.Clip())
+	})
+}
+
+func TestSheet_Wrap(t *testing.T) {
+	sheet := newBlockSheet()
+	// when
+	lines := sheet.Wrap("ab a  b\nc", 6)
+	// then
+	assert.Equal(t, []string{"ab", "a  b", "c"}, lines)
+	assert.Empty(t, sheet.Wrap("", 6))
+}
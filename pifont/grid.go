@@ -0,0 +1,119 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pifont
+
+import (
+	"fmt"
+
+	"github.com/elgopher/pi"
+)
+
+// Grid describes how glyphs are laid out on a canvas used by NewGridSheet.
+type Grid struct {
+	// CellW and CellH specify the size of each cell in the grid.
+	// Glyphs are aligned to the top-left corner of their cells.
+	CellW, CellH int
+
+	FgColor pi.Color // font color on the canvas
+	BgColor pi.Color // background color on the canvas
+
+	// LetterSpacing is the number of empty columns added after each glyph.
+	LetterSpacing int
+	// SpaceWidth is the width of glyphs without any pixels, such as space.
+	SpaceWidth int
+	// Monospaced gives all chars the width of the widest detected glyph.
+	Monospaced bool
+
+	// Width, when not nil, returns the final width of the char.
+	// detected is the width found by NewGridSheet, including LetterSpacing.
+	// Use it to override widths of some characters.
+	Width func(char rune, detected int) int
+}
+
+// NewGridSheet creates a Sheet from a canvas with glyphs laid out in a grid.
+//
+// chars lists characters in the same order as cells in the grid,
+// row by row, starting from the top-left cell.
+//
+// The width of each glyph is detected from its rightmost column
+// containing a pixel different from BgColor. Then LetterSpacing is added.
+// Sprites never get wider than CellW. When the width is bigger than CellW,
+// for example because of LetterSpacing, it is stored in Sheet.Advance.
+//
+// It panics if the cell size is not positive or there are more chars
+// than cells.
+func NewGridSheet(canvas pi.Canvas, chars string, grid Grid) Sheet {
+	if grid.CellW <= 0 || grid.CellH <= 0 {
+		panic(fmt.Sprintf("invalid cell size %dx%d", grid.CellW, grid.CellH))
+	}
+
+	cols := canvas.W() / grid.CellW
+	rows := canvas.H() / grid.CellH
+
+	sheet := Sheet{
+		Chars:   map[rune]pi.Sprite{},
+		Height:  grid.CellH,
+		FgColor: grid.FgColor,
+		BgColor: grid.BgColor,
+	}
+
+	var cells []pi.IntArea
+	var widths []int
+	maxWidth := 0
+	for idx := range []rune(chars) {
+		if idx >= cols*rows {
+			panic(fmt.Sprintf("too many chars for the %dx%d grid", cols, rows))
+		}
+
+		cell := pi.IntArea{
+			X: idx % cols * grid.CellW,
+			Y: idx / cols * grid.CellH,
+			W: grid.CellW,
+			H: grid.CellH,
+		}
+
+		width := grid.SpaceWidth
+		if right := rightmostColumn(canvas, cell, grid.BgColor); right >= 0 {
+			width = right + 1 + grid.LetterSpacing
+		}
+		cells = append(cells, cell)
+		widths = append(widths, width)
+		maxWidth = max(maxWidth, width)
+	}
+
+	for idx, char := range []rune(chars) {
+		cell, width := cells[idx], widths[idx]
+		if grid.Monospaced {
+			width = maxWidth
+		}
+		if grid.Width != nil {
+			width = grid.Width(char, width)
+		}
+		width = max(width, 0)
+		cell.W = min(width, grid.CellW)
+
+		sheet.Chars[char] = pi.Sprite{Area: cell, Source: canvas}
+		if width != cell.W {
+			if sheet.Advance == nil {
+				sheet.Advance = map[rune]int{}
+			}
+			sheet.Advance[char] = width
+		}
+	}
+
+	return sheet
+}
+
+// rightmostColumn returns the index of the rightmost column in the area
+// (relative to area.X) with a pixel different from bgColor, or -1.
+func rightmostColumn(canvas pi.Canvas, area pi.IntArea, bgColor pi.Color) int {
+	for x := area.W - 1; x >= 0; x-- {
+		for y := 0; y < area.H; y++ {
+			if canvas.Get(area.X+x, area.Y+y) != bgColor {
+				return x
+			}
+		}
+	}
+	return -1
+}
@@ -0,0 +1,92 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pifont_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elgopher/pi"
+	"github.com/elgopher/pi/pifont"
+)
+
+// newGridCanvas creates a canvas with 2x2 grid of 4x2 cells and background color 5:
+//
+//	1 5 5 5 | 1 1 1 5
+//	5 5 5 5 | 5 1 5 5
+//	--------+--------
+//	5 5 5 5 | 1 1 1 1
+//	5 5 5 5 | 1 1 1 1
+func newGridCanvas() pi.Canvas {
+	canvas := pi.NewCanvas(8, 4)
+	canvas.SetAll(
+		1, 5, 5, 5, 1, 1, 1, 5,
+		5, 5, 5, 5, 5, 1, 5, 5,
+		5, 5, 5, 5, 1, 1, 1, 1,
+		5, 5, 5, 5, 1, 1, 1, 1,
+	)
+	return canvas
+}
+
+func TestNewGridSheet(t *testing.T) {
+	canvas := newGridCanvas()
+
+	t.Run("should detect widths", func(t *testing.T) {
+		sheet := pifont.NewGridSheet(canvas, "iT #", pifont.Grid{
+			CellW: 4, CellH: 2, FgColor: 1, BgColor: 5,
+			LetterSpacing: 1,
+			SpaceWidth:    2,
+		})
+		assert.Equal(t, 2, sheet.Height)
+		assert.Equal(t, pi.Color(1), sheet.FgColor)
+		assert.Equal(t, pi.Color(5), sheet.BgColor)
+		require.Len(t, sheet.Chars, 4)
+		assert.Equal(t, pi.IntArea{X: 0, Y: 0, W: 2, H: 2}, sheet.Chars['i'].Area)
+		assert.Equal(t, pi.IntArea{X: 4, Y: 0, W: 4, H: 2}, sheet.Chars['T'].Area)
+		assert.Equal(t, pi.IntArea{X: 0, Y: 2, W: 2, H: 2}, sheet.Chars[' '].Area)
+		assert.Equal(t, pi.IntArea{X: 4, Y: 2, W: 4, H: 2}, sheet.Chars['#'].Area, "width limited to cell")
+		assert.Equal(t, map[rune]int{'#': 5}, sheet.Advance, "spacing added on top of the cell width")
+	})
+
+	t.Run("should use the widest detected width for monospaced font", func(t *testing.T) {
+		sheet := pifont.NewGridSheet(canvas, "iT ", pifont.Grid{
+			CellW: 4, CellH: 2, BgColor: 5,
+			LetterSpacing: 1,
+			SpaceWidth:    2,
+			Monospaced:    true,
+		})
+		assert.Equal(t, 4, sheet.Chars['i'].W)
+		assert.Equal(t, 4, sheet.Chars['T'].W)
+		assert.Equal(t, 4, sheet.Chars[' '].W)
+		assert.Empty(t, sheet.Advance)
+	})
+
+	t.Run("should use Width function", func(t *testing.T) {
+		var detectedWidths []int
+		sheet := pifont.NewGridSheet(canvas, "iT", pifont.Grid{
+			CellW: 4, CellH: 2, BgColor: 5,
+			Width: func(char rune, detected int) int {
+				detectedWidths = append(detectedWidths, detected)
+				return 3
+			},
+		})
+		assert.Equal(t, []int{1, 3}, detectedWidths)
+		assert.Equal(t, 3, sheet.Chars['i'].W)
+		assert.Equal(t, 3, sheet.Chars['T'].W)
+	})
+
+	t.Run("should panic when there are too many chars", func(t *testing.T) {
+		assert.Panics(t, func() {
+			pifont.NewGridSheet(canvas, "abcde", pifont.Grid{CellW: 4, CellH: 2})
+		})
+	})
+
+	t.Run("should panic for invalid cell size", func(t *testing.T) {
+		assert.Panics(t, func() {
+			pifont.NewGridSheet(canvas, "a", pifont.Grid{CellW: 0, CellH: 2})
+		})
+	})
+}
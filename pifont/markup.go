@@ -0,0 +1,181 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pifont
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/elgopher/pi"
+)
+
+// PrintMarkup draws text with inline markup, starting with the current draw color.
+//
+// The following tags are supported:
+//
+//	{c:8}text{/c}   - draws text using color 8
+//	{s:1}text{/s}   - draws text with stroke in color 1 (like PrintStroked)
+//	{sh:1}text{/sh} - draws text with shadow in color 1
+//	{i:name}        - draws the icon from Sheet.Icons using its original colors
+//	{{              - draws the "{" character
+//
+// Color tags can be nested. Unknown tags and tags without closing brace
+// are printed as regular text. Strokes and shadows of the whole text are drawn
+// first, so they never cover the text drawn earlier.
+//
+// Returns the x, y position where you can continue writing text.
+func (s Sheet) PrintMarkup(text string, x, y int) (currentX, currentY int) {
+	originalColor := pi.GetColor()
+	s.markup(text, x, y, passEffects)
+	pi.SetColor(originalColor)
+	currentX, currentY = s.markup(text, x, y, passText)
+	pi.SetColor(originalColor)
+	return
+}
+
+// SizeMarkup returns the dimensions of the text with markup,
+// without rendering it. Tags are not included in the size, but icons are.
+func (s Sheet) SizeMarkup(text string) (width, height int) {
+	return s.markup(text, 0, 0, passLayout)
+}
+
+var colorStack []pi.Color // reused between calls to avoid allocations
+
+// markupPass specifies what is drawn by Sheet.markup.
+type markupPass int
+
+const (
+	passLayout  markupPass = iota // nothing is drawn, text is only measured
+	passEffects                   // strokes and shadows
+	passText                      // text and icons
+)
+
+type markupStyle struct {
+	stroke, shadow           bool
+	strokeColor, shadowColor pi.Color
+}
+
+// markup lays out the text with markup and draws the parts selected by pass.
+func (s Sheet) markup(text string, x, y int, pass markupPass) (maxX, currentY int) {
+	colorStack = append(colorStack[:0], pi.GetColor())
+	var style markupStyle
+
+	maxX = x
+	currentX := x
+	currentY = y
+	var prev rune // last printed char, 0 at the beginning of the line or after the icon
+
+	for len(text) > 0 {
+		switch text[0] {
+		case '\n':
+			currentX = x
+			currentY += s.lineHeightOrDefault()
+			prev = 0
+			text = text[1:]
+			continue
+		case '{':
+			if strings.HasPrefix(text, "{{") {
+				currentX, prev = s.printRun("{", prev, currentX, currentY, style, pass)
+				maxX = max(maxX, currentX)
+				text = text[2:]
+				continue
+			}
+			end := strings.IndexByte(text, '}')
+			if end > 0 && s.applyTag(text[1:end], &currentX, currentY, &style, &prev, pass) {
+				maxX = max(maxX, currentX)
+				text = text[end+1:]
+				continue
+			}
+		}
+
+		// regular text until the next tag or new line:
+		runEnd := strings.IndexAny(text[1:], "{\n") + 1
+		if runEnd == 0 {
+			runEnd = len(text)
+		}
+		currentX, prev = s.printRun(text[:runEnd], prev, currentX, currentY, style, pass)
+		maxX = max(maxX, currentX)
+		text = text[runEnd:]
+	}
+
+	return maxX, currentY
+}
+
+// applyTag applies the tag and returns false if the tag is unknown.
+func (s Sheet) applyTag(tag string, x *int, y int, style *markupStyle, prev *rune, pass markupPass) bool {
+	name, arg, _ := strings.Cut(tag, ":")
+
+	parseColor := func() (pi.Color, bool) {
+		c, err := strconv.Atoi(arg)
+		return pi.Color(c), err == nil && c >= 0 && c < 256
+	}
+
+	switch name {
+	case "c":
+		c, ok := parseColor()
+		if !ok {
+			return false
+		}
+		colorStack = append(colorStack, c)
+	case "/c":
+		if len(colorStack) > 1 {
+			colorStack = colorStack[:len(colorStack)-1]
+		}
+	case "s":
+		c, ok := parseColor()
+		if !ok {
+			return false
+		}
+		style.stroke, style.strokeColor = true, c
+	case "/s":
+		style.stroke = false
+	case "sh":
+		c, ok := parseColor()
+		if !ok {
+			return false
+		}
+		style.shadow, style.shadowColor = true, c
+	case "/sh":
+		style.shadow = false
+	case "i":
+		icon, ok := s.Icons[arg]
+		if !ok {
+			return false
+		}
+		if pass == passText {
+			pi.DrawSprite(icon, *x, y)
+		}
+		*x += icon.W
+		*prev = 0
+	default:
+		return false
+	}
+
+	return true
+}
+
+// printRun prints a single line of text without markup, separated from the prev char
+// by letter spacing and kerning. Returns the x position after the text and its last char.
+func (s Sheet) printRun(run string, prev rune, x, y int, style markupStyle, pass markupPass) (int, rune) {
+	first, _ := utf8.DecodeRuneInString(run)
+	if prev != 0 {
+		x += s.spacing(prev, first)
+	}
+	last, _ := utf8.DecodeLastRuneInString(run)
+
+	switch {
+	case pass == passEffects && style.stroke:
+		pi.SetColor(style.strokeColor)
+		s.printStroke(run, x, y)
+	case pass == passEffects && style.shadow:
+		pi.SetColor(style.shadowColor)
+		s.Print(run, x+1, y+1)
+	case pass == passText:
+		pi.SetColor(colorStack[len(colorStack)-1])
+		s.Print(run, x, y)
+	}
+
+	return x + s.textWidth(run), last
+}
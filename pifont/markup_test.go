@@ -0,0 +1,122 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pifont_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elgopher/pi"
+	"github.com/elgopher/pi/pifont"
+)
+
+func newMarkupSheet() pifont.Sheet {
+	sheet := newBlockSheet()
+	icons := pi.NewCanvas(1, 2)
+	icons.SetAll(5, 6)
+	sheet.Icons = map[string]pi.Sprite{
+		"btn": pi.SpriteFrom(icons, 0, 0, 1, 2),
+	}
+	return sheet
+}
+
+func TestSheet_SizeMarkup(t *testing.T) {
+	sheet := newMarkupSheet()
+
+	tests := map[string]struct {
+		text           string
+		expectedWidth  int
+		expectedHeight int
+	}{
+		"empty": {
+			text: "", expectedWidth: 0, expectedHeight: 0,
+		},
+		"tags are not measured": {
+			text: "a{c:8}b{/c}", expectedWidth: 4,
+		},
+		"stroke and shadow are not measured": {
+			text: "{s:1}a{/s}{sh:1}b{/sh}", expectedWidth: 4,
+		},
+		"icon": {
+			text: "{i:btn}a", expectedWidth: 3,
+		},
+		"new line": {
+			text: "a{c:1}\nab", expectedWidth: 4, expectedHeight: 2,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			width, height := sheet.SizeMarkup(test.text)
+			assert.Equal(t, test.expectedWidth, width)
+			assert.Equal(t, test.expectedHeight, height)
+		})
+	}
+}
+
+func TestSheet_PrintMarkup(t *testing.T) {
+	sheet := newMarkupSheet()
+
+	print := func(text string) pi.Canvas {
+		pi.SetScreenSize(6, 3)
+		pi.SetDrawTarget(pi.Screen())
+		pi.Cls()
+		pi.SetColor(7)
+		sheet.PrintMarkup(text, 0, 0)
+		return pi.Screen()
+	}
+
+	t.Run("should change color", func(t *testing.T) {
+		screen := print("a{c:8}a{c:9}{/c}{/c}a")
+		assert.Equal(t, []pi.Color{
+			7, 7, 8, 8, 7, 7,
+			7, 7, 8, 8, 7, 7,
+			0, 0, 0, 0, 0, 0,
+		}, screen.Data())
+	})
+
+	t.Run("should restore color", func(t *testing.T) {
+		print("{c:8}a")
+		assert.Equal(t, pi.Color(7), pi.GetColor())
+	})
+
+	t.Run("should draw shadow", func(t *testing.T) {
+		screen := print("{sh:1}a{/sh}")
+		assert.Equal(t, []pi.Color{
+			7, 7, 0, 0, 0, 0,
+			7, 7, 1, 0, 0, 0,
+			0, 1, 1, 0, 0, 0,
+		}, screen.Data())
+	})
+
+	t.Run("should not draw stroke over text drawn earlier", func(t *testing.T) {
+		screen := print("{s:1}a{c:8}b{/c}{/s}")
+		assert.Equal(t, []pi.Color{
+			7, 7, 8, 8, 1, 0,
+			7, 7, 8, 8, 1, 0,
+			1, 1, 1, 1, 1, 0,
+		}, screen.Data())
+	})
+
+	t.Run("should draw icon", func(t *testing.T) {
+		screen := print("{i:btn}a")
+		assert.Equal(t, []pi.Color{
+			5, 7, 7, 0, 0, 0,
+			6, 7, 7, 0, 0, 0,
+			0, 0, 0, 0, 0, 0,
+		}, screen.Data())
+	})
+
+	t.Run("should print unknown tag as text", func(t *testing.T) {
+		x, y := sheet.PrintMarkup("{i:unknown}{x}a", 0, 0)
+		assert.Equal(t, 2, x)
+		assert.Equal(t, 0, y)
+	})
+
+	t.Run("should return position after the text", func(t *testing.T) {
+		x, y := sheet.PrintMarkup("a\n{i:btn}", 1, 1)
+		assert.Equal(t, 3, x)
+		assert.Equal(t, 3, y)
+	})
+}
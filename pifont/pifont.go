@@ -15,6 +15,51 @@ type Sheet struct {
 	Height  int
 	FgColor pi.Color // font color on sprites
 	BgColor pi.Color // background color on sprites
+	// Icons are sprites which can be inserted into text printed
+	// by PrintMarkup, for example "Press {i:x} to jump".
+	Icons map[string]pi.Sprite
+	// LineHeight is the distance between lines. When 0, Height is used.
+	LineHeight int
+	// Advance overrides the horizontal distance to the next character
+	// for selected characters. By default, the sprite width is used.
+	Advance map[rune]int
+	// Bearing shifts sprites of selected characters horizontally, relative
+	// to the current position. Negative values draw the sprite to the left,
+	// for example for glyphs overhanging the previous character.
+	Bearing map[rune]int
+	// LetterSpacing is added between characters of the same line.
+	// Can be negative.
+	LetterSpacing int
+	// Kerning adjusts the distance between specific pairs of characters,
+	// for example {'A', 'V'}: -1. It is added to LetterSpacing.
+	Kerning map[KerningPair]int
+}
+
+// KerningPair is a pair of adjacent characters, First followed by Second.
+type KerningPair struct {
+	First, Second rune
+}
+
+// lineHeightOrDefault returns the distance between lines.
+func (s Sheet) lineHeightOrDefault() int {
+	if s.LineHeight > 0 {
+		return s.LineHeight
+	}
+	return s.Height
+}
+
+// advance returns the horizontal distance from char r to the next character,
+// without letter spacing and kerning.
+func (s Sheet) advance(r rune) int {
+	if a, ok := s.Advance[r]; ok {
+		return a
+	}
+	return s.Chars[r].W
+}
+
+// spacing returns the additional distance between adjacent chars prev and next.
+func (s Sheet) spacing(prev, next rune) int {
+	return s.LetterSpacing + s.Kerning[KerningPair{First: prev, Second: next}]
 }
 
 var intermediateCanvas pi.Canvas // text is first rendered here to change its color from FgColor to selected color
@@ -27,6 +72,7 @@ var prevBgColorTable [pi.MaxColors]pi.Color
 // Returns the x, y position where you can continue writing text.
 func (s Sheet) Print(str string, x, y int) (currentX, currentY int) {
 	originalDrawTarget := pi.DrawTarget()
+	originalClip := pi.Clip()
 	if intermediateCanvas.W() != originalDrawTarget.W() || intermediateCanvas.H() != originalDrawTarget.H() {
 		intermediateCanvas = pi.NewCanvas(originalDrawTarget.W(), originalDrawTarget.H())
 	}
@@ -44,7 +90,8 @@ func (s Sheet) Print(str string, x, y int) (currentX, currentY int) {
 	pi.SetDrawTarget(intermediateCanvas)
 
 	// first draw text in selected color on intermediateCanvas
-	currentX, currentY = s.PrintOriginal(str, x, y)
+	var left, right int
+	currentX, currentY, left, right = s.printOriginal(str, x, y)
 
 	// revert color tables
 	pi.ColorTables[0][s.FgColor] = prevFgColorTable
@@ -54,18 +101,21 @@ func (s Sheet) Print(str string, x, y int) (currentX, currentY int) {
 	prevBgColorTable = pi.ColorTables[0][bgColor]
 	pi.SetTransparency(bgColor, true)
 
-	// now copy text in target color on original draw target
+	// now copy text in target color on original draw target.
+	// Sprites can be shifted by Bearing or be wider than their advance,
+	// so the edges of drawn sprites are used:
 	coloredText := pi.Sprite{
 		Area: pi.Area[int]{
-			X: x - pi.Camera.X,
+			X: left - pi.Camera.X,
 			Y: y - pi.Camera.Y,
-			W: currentX - x,
+			W: right - left,
 			H: currentY - y + s.Height,
 		},
 		Source: intermediateCanvas,
 	}
 	pi.SetDrawTarget(originalDrawTarget)
-	pi.DrawSprite(coloredText, x, y)
+	pi.SetClip(originalClip)
+	pi.DrawSprite(coloredText, left, y)
 
 	// revert bgColor transparency
 	pi.ColorTables[0][bgColor] = prevBgColorTable
@@ -74,20 +124,39 @@ func (s Sheet) Print(str string, x, y int) (currentX, currentY int) {
 }
 
 // PrintOriginal prints the text using its original colors.
+//
+// Characters are positioned using LineHeight, Advance, Bearing, LetterSpacing and Kerning.
 func (s Sheet) PrintOriginal(str string, x, y int) (maxX, currentY int) {
+	maxX, currentY, _, _ = s.printOriginal(str, x, y)
+	return
+}
+
+// printOriginal works like PrintOriginal, but also returns the left and right edges of drawn sprites.
+func (s Sheet) printOriginal(str string, x, y int) (maxX, currentY, left, right int) {
 	maxX = x
+	left = x
+	right = x
 	currentX := x
 	currentY = y
+	var prev rune // 0 at the beginning of the line
 	for _, r := range str {
 		if r == '\n' {
 			currentX = x
-			currentY += s.Height
+			currentY += s.lineHeightOrDefault()
+			prev = 0
 			continue
 		}
+		if prev != 0 {
+			currentX += s.spacing(prev, r)
+		}
 		sprite := s.Chars[r]
-		pi.DrawSprite(sprite, currentX, currentY)
-		currentX += sprite.W
+		spriteX := currentX + s.Bearing[r]
+		pi.DrawSprite(sprite, spriteX, currentY)
+		left = min(left, spriteX)
+		right = max(right, spriteX+sprite.W)
+		currentX += s.advance(r)
 		maxX = max(maxX, currentX)
+		prev = r
 	}
 
 	return
@@ -98,11 +167,7 @@ func (s Sheet) PrintOriginal(str string, x, y int) (maxX, currentY int) {
 // The text is drawn using the specified foreground and stroke colors.
 func (s Sheet) PrintStroked(text string, x, y int, fgColor, strokeColor pi.Color) (currentX, currentY int) {
 	prevColor := pi.SetColor(strokeColor)
-	for l := y - 1; l <= y+1; l++ {
-		s.Print(text, x-1, l)
-		s.Print(text, x, l)
-		s.Print(text, x+1, l)
-	}
+	s.printStroke(text, x, y)
 
 	pi.SetColor(fgColor)
 	currentX, currentY = s.Print(text, x, y)
@@ -112,10 +177,23 @@ func (s Sheet) PrintStroked(text string, x, y int, fgColor, strokeColor pi.Color
 	return
 }
 
+// printStroke draws only the stroke of the text, using the current draw color.
+func (s Sheet) printStroke(text string, x, y int) {
+	for l := y - 1; l <= y+1; l++ {
+		s.Print(text, x-1, l)
+		s.Print(text, x, l)
+		s.Print(text, x+1, l)
+	}
+}
+
 // Size returns the dimensions of the text without rendering it to the draw target.
 func (s Sheet) Size(text string) (width, height int) {
+	originalClip := pi.Clip()
 	originalDrawTarget := pi.SetDrawTarget(intermediateCanvas)
-	defer pi.SetDrawTarget(originalDrawTarget)
+	defer func() {
+		pi.SetDrawTarget(originalDrawTarget)
+		pi.SetClip(originalClip)
+	}()
 
 	return s.PrintOriginal(text, 0, 0)
 }
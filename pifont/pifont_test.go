@@ -8,6 +8,8 @@ import (
 	"github.com/elgopher/pi/pitest"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
 	"github.com/elgopher/pi"
 	"github.com/elgopher/pi/pifont"
 )
@@ -115,3 +117,84 @@ func BenchmarkSheet_Print(b *testing.B) {
 		sheet.Print("aaaaaaaaaaaaaaaaaaaa", 100, 100)
 	}
 }
+
+func TestSheet_Size(t *testing.T) {
+	tests := map[string]struct {
+		modify         func(*pifont.Sheet)
+		text           string
+		expectedWidth  int
+		expectedHeight int
+	}{
+		"default": {
+			modify: func(*pifont.Sheet) {}, text: "ab\nc", expectedWidth: 4, expectedHeight: 2,
+		},
+		"line height": {
+			modify: func(s *pifont.Sheet) { s.LineHeight = 3 }, text: "a\nb\nc", expectedWidth: 2, expectedHeight: 6,
+		},
+		"advance": {
+			modify: func(s *pifont.Sheet) { s.Advance = map[rune]int{'a': 3} }, text: "aab", expectedWidth: 8,
+		},
+		"letter spacing": {
+			modify: func(s *pifont.Sheet) { s.LetterSpacing = 1 }, text: "abc\na", expectedWidth: 8,
+			expectedHeight: 2,
+		},
+		"kerning": {
+			modify: func(s *pifont.Sheet) {
+				s.LetterSpacing = 1
+				s.Kerning = map[pifont.KerningPair]int{{First: 'a', Second: 'b'}: -2}
+			},
+			text: "abba", expectedWidth: 9,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			sheet := newBlockSheet()
+			test.modify(&sheet)
+			// when
+			width, height := sheet.Size(test.text)
+			// then
+			assert.Equal(t, test.expectedWidth, width)
+			assert.Equal(t, test.expectedHeight, height)
+		})
+	}
+}
+
+func TestSheet_PrintWithSpacing(t *testing.T) {
+	sheet := newBlockSheet()
+	sheet.Advance = map[rune]int{'a': 1}
+	sheet.LetterSpacing = 1
+	sheet.LineHeight = 3
+	pi.SetScreenSize(5, 5)
+	pi.SetDrawTarget(pi.Screen())
+	pi.Cls()
+	pi.SetColor(7)
+	// when
+	x, y := sheet.Print("ab\na", 0, 0)
+	// then
+	assert.Equal(t, 4, x)
+	assert.Equal(t, 3, y)
+	assert.Equal(t, []pi.Color{
+		7, 7, 7, 7, 0,
+		7, 7, 7, 7, 0,
+		0, 0, 0, 0, 0,
+		7, 7, 0, 0, 0,
+		7, 7, 0, 0, 0,
+	}, pi.Screen().Data())
+}
+
+func TestSheet_PrintWithBearing(t *testing.T) {
+	sheet := newBlockSheet()
+	sheet.Bearing = map[rune]int{'b': -1}
+	pi.SetScreenSize(4, 2)
+	pi.SetDrawTarget(pi.Screen())
+	pi.Cls()
+	pi.SetColor(7)
+	// when
+	x, _ := sheet.Print("b", 1, 0)
+	// then
+	assert.Equal(t, 3, x)
+	assert.Equal(t, []pi.Color{
+		7, 7, 0, 0,
+		7, 7, 0, 0,
+	}, pi.Screen().Data())
+}
@@ -0,0 +1,211 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+// Package pimap provides tile maps built on top of pi.Surface.
+//
+// A Map stores tile indices in one or more layers. Each tile index
+// references a sprite in a Tileset, which is a sprite sheet divided
+// into a grid. When drawn, only the tiles visible through the current
+// clipping region are rendered.
+package pimap
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/elgopher/pi"
+)
+
+// Tile is an index of a sprite in the Tileset.
+//
+// Tile 0 is considered empty and is never drawn (just like in Pico-8).
+type Tile = uint16
+
+// Map is a tile map composed of layers of equal size.
+//
+// Layer 0 is drawn first, so it is at the bottom.
+type Map struct {
+	Tileset *Tileset
+	Layers  []pi.Surface[Tile]
+}
+
+// New creates a new Map with the specified number of layers.
+//
+// w and h specify the size of each layer in tiles.
+func New(tileset *Tileset, w, h, layers int) *Map {
+	if layers <= 0 {
+		panic(fmt.Sprintf("number of layers %d must be greater than 0", layers))
+	}
+
+	m := &Map{
+		Tileset: tileset,
+		Layers:  make([]pi.Surface[Tile], layers),
+	}
+	for i := range m.Layers {
+		m.Layers[i] = pi.NewSurface[Tile](w, h)
+	}
+	return m
+}
+
+// W returns the width of the map in tiles.
+func (m *Map) W() int {
+	return m.Layers[0].W()
+}
+
+// H returns the height of the map in tiles.
+func (m *Map) H() int {
+	return m.Layers[0].H()
+}
+
+// Get returns the tile at (x, y) in the given layer.
+//
+// If the coordinates are out of bounds, it returns 0.
+func (m *Map) Get(layer, x, y int) Tile {
+	return m.Layers[layer].Get(x, y)
+}
+
+// Set sets the tile at (x, y) in the given layer.
+//
+// If the coordinates are out of bounds, it does nothing.
+func (m *Map) Set(layer, x, y int, tile Tile) {
+	m.Layers[layer].Set(x, y, tile)
+}
+
+// Fill sets all tiles in the area of the given layer.
+//
+// The area is clipped by the map bounds.
+func (m *Map) Fill(layer int, area pi.IntArea, tile Tile) {
+	l := m.Layers[layer]
+	area, _, _ = area.ClippedBy(l.EntireArea())
+	for _, line := range l.LinesIterator(area) {
+		for i := range line {
+			line[i] = tile
+		}
+	}
+}
+
+// Flag reports whether the flag (0..7) is set for the tile at (x, y)
+// in the given layer.
+func (m *Map) Flag(layer, x, y, flag int) bool {
+	return m.Tileset.Flag(m.Get(layer, x, y), flag)
+}
+
+// TilePosition converts world pixel coordinates to tile coordinates.
+//
+// The map is assumed to be drawn at (0, 0). Coordinates outside the map
+// are converted too, so they may be negative or exceed the map size.
+func (m *Map) TilePosition(worldX, worldY int) (x, y int) {
+	return floorDiv(worldX, m.Tileset.TileW), floorDiv(worldY, m.Tileset.TileH)
+}
+
+// WorldPosition converts tile coordinates to world pixel coordinates
+// of the tile's top-left corner.
+//
+// The map is assumed to be drawn at (0, 0).
+func (m *Map) WorldPosition(x, y int) (worldX, worldY int) {
+	return x * m.Tileset.TileW, y * m.Tileset.TileH
+}
+
+// Draw draws all layers with the map's top-left corner at (x, y).
+//
+// Only tiles visible in the clipping region are drawn.
+//
+// It takes into account the camera position, clipping region,
+// color tables, and masks.
+func (m *Map) Draw(x, y int) {
+	for layer := range m.Layers {
+		m.DrawLayer(layer, x, y)
+	}
+}
+
+// DrawLayer draws a single layer with the map's top-left corner at (x, y).
+//
+// Only tiles visible in the clipping region are drawn.
+//
+// It takes into account the camera position, clipping region,
+// color tables, and masks.
+func (m *Map) DrawLayer(layer, x, y int) {
+	l := m.Layers[layer]
+	tileW, tileH := m.Tileset.TileW, m.Tileset.TileH
+
+	clip := pi.Clip()
+	if clip.W == 0 || clip.H == 0 {
+		return
+	}
+
+	// visible region in map pixel coordinates:
+	left := clip.X + pi.Camera.X - x
+	top := clip.Y + pi.Camera.Y - y
+	right := left + clip.W - 1
+	bottom := top + clip.H - 1
+
+	visible := pi.IntArea{
+		X: floorDiv(left, tileW),
+		Y: floorDiv(top, tileH),
+	}
+	visible.W = floorDiv(right, tileW) - visible.X + 1
+	visible.H = floorDiv(bottom, tileH) - visible.Y + 1
+	visible, _, _ = visible.ClippedBy(l.EntireArea())
+
+	for pos, line := range l.LinesIterator(visible) {
+		dy := y + pos.Y*tileH
+		for i, tile := range line {
+			if tile == 0 {
+				continue
+			}
+			dx := x + (pos.X+i)*tileW
+			pi.DrawSprite(m.Tileset.Sprite(tile), dx, dy)
+		}
+	}
+}
+
+// TLine draws a textured line between (x0, y0) and (x1, y1), inclusive,
+// with pixels sampled from the given layer.
+//
+// (u, v) specify the starting position on the map in pixels.
+// For each pixel drawn, the position is advanced by (du, dv).
+// Empty tiles and positions outside the map are not drawn.
+//
+// It takes into account the camera position, clipping region,
+// color tables, and masks.
+func (m *Map) TLine(layer, x0, y0, x1, y1 int, u, v, du, dv float64) {
+	pi.TLineFunc(x0, y0, x1, y1, u, v, du, dv, m.sampler(layer))
+}
+
+// Mode7 draws the layer as a floor plane in perspective (like the SNES Mode 7).
+//
+// Camera position is specified in map pixels.
+// Empty tiles and positions outside the map are not drawn.
+//
+// It takes into account the camera position, clipping region,
+// color tables, and masks.
+func (m *Map) Mode7(layer int, area pi.IntArea, camera pi.Mode7Camera) {
+	pi.Mode7Func(area, camera, m.sampler(layer))
+}
+
+func (m *Map) sampler(layer int) func(u, v float64) (pi.Color, bool) {
+	l := m.Layers[layer]
+	tileW, tileH := m.Tileset.TileW, m.Tileset.TileH
+	cols := m.Tileset.Cols()
+	source := m.Tileset.Source
+
+	return func(u, v float64) (pi.Color, bool) {
+		x, y := int(math.Floor(u)), int(math.Floor(v))
+		tile := l.Get(floorDiv(x, tileW), floorDiv(y, tileH))
+		if tile == 0 || int(tile) >= m.Tileset.Len() {
+			return 0, false
+		}
+
+		sx := int(tile)%cols*tileW + x - floorDiv(x, tileW)*tileW
+		sy := int(tile)/cols*tileH + y - floorDiv(y, tileH)*tileH
+		return source.Get(sx, sy), true
+	}
+}
+
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
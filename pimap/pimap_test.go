@@ -0,0 +1,165 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pimap_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elgopher/pi"
+	"github.com/elgopher/pi/pimap"
+	"github.com/elgopher/pi/pitest"
+)
+
+// newTileset creates a tileset with 3 tiles 2x2 filled with colors 1, 2 and 3.
+func newTileset() *pimap.Tileset {
+	sheet := pi.NewCanvas(6, 2)
+	sheet.SetAll(
+		1, 1, 2, 2, 3, 3,
+		1, 1, 2, 2, 3, 3,
+	)
+	return pimap.NewTileset(sheet, 2, 2)
+}
+
+func TestTileset_Sprite(t *testing.T) {
+	tileset := newTileset()
+	assert.Equal(t, 3, tileset.Len())
+
+	pitest.AssertSpriteEqual(t,
+		pi.SpriteFrom(tileset.Source, 4, 0, 2, 2),
+		tileset.Sprite(2))
+	pitest.AssertSpriteEqual(t,
+		pi.Sprite{Source: tileset.Source},
+		tileset.Sprite(3))
+}
+
+func TestTileset_Flag(t *testing.T) {
+	tileset := newTileset()
+	// when
+	tileset.SetFlag(1, 0, true)
+	tileset.SetFlag(1, 7, true)
+	// then
+	assert.True(t, tileset.Flag(1, 0))
+	assert.True(t, tileset.Flag(1, 7))
+	assert.False(t, tileset.Flag(1, 1))
+	assert.False(t, tileset.Flag(0, 0))
+	assert.Equal(t, uint8(0b10000001), tileset.Flags(1))
+	// when
+	tileset.SetFlag(1, 0, false)
+	// then
+	assert.Equal(t, uint8(0b10000000), tileset.Flags(1))
+	// out of range tiles are ignored
+	tileset.SetFlags(100, 0xFF)
+	assert.Zero(t, tileset.Flags(100))
+}
+
+func TestMap_Fill(t *testing.T) {
+	m := pimap.New(newTileset(), 3, 2, 1)
+	// when
+	m.Fill(0, pi.IntArea{X: 1, Y: -1, W: 5, H: 2}, 2)
+	// then
+	expected := pi.NewSurface[pimap.Tile](3, 2)
+	expected.SetAll(
+		0, 2, 2,
+		0, 0, 0,
+	)
+	pitest.AssertSurfaceEqual(t, expected, m.Layers[0])
+}
+
+func TestMap_TilePosition(t *testing.T) {
+	m := pimap.New(newTileset(), 3, 2, 1)
+
+	tests := map[string]struct {
+		worldX, worldY int
+		x, y           int
+	}{
+		"origin":         {worldX: 0, worldY: 0, x: 0, y: 0},
+		"inside tile":    {worldX: 1, worldY: 1, x: 0, y: 0},
+		"next tile":      {worldX: 2, worldY: 3, x: 1, y: 1},
+		"negative":       {worldX: -1, worldY: -2, x: -1, y: -1},
+		"negative far":   {worldX: -3, worldY: -5, x: -2, y: -3},
+		"outside of map": {worldX: 100, worldY: 4, x: 50, y: 2},
+	}
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			x, y := m.TilePosition(testCase.worldX, testCase.worldY)
+			assert.Equal(t, testCase.x, x)
+			assert.Equal(t, testCase.y, y)
+		})
+	}
+
+	t.Run("world position", func(t *testing.T) {
+		x, y := m.WorldPosition(2, 1)
+		assert.Equal(t, 4, x)
+		assert.Equal(t, 2, y)
+	})
+}
+
+func TestMap_Draw(t *testing.T) {
+	t.Run("should draw all layers", func(t *testing.T) {
+		pi.SetScreenSize(4, 4)
+		pi.SetDrawTarget(pi.Screen())
+		pi.Cls()
+		pi.Camera = pi.Position{}
+		m := pimap.New(newTileset(), 2, 2, 2)
+		m.Layers[0].SetAll(
+			1, 1,
+			1, 1,
+		)
+		m.Set(1, 1, 1, 2)
+		// when
+		m.Draw(0, 0)
+		// then
+		expected := pi.NewCanvas(4, 4)
+		expected.SetAll(
+			2, 2, 2, 2,
+			2, 2, 2, 2,
+			2, 2, 3, 3,
+			2, 2, 3, 3,
+		)
+		pitest.AssertSurfaceEqual(t, expected, pi.Screen())
+	})
+
+	t.Run("should take camera and clip into account", func(t *testing.T) {
+		pi.SetScreenSize(4, 4)
+		pi.SetDrawTarget(pi.Screen())
+		pi.Cls()
+		pi.Camera = pi.Position{X: 1, Y: 1}
+		defer func() {
+			pi.Camera = pi.Position{}
+		}()
+		pi.SetClip(pi.IntArea{X: 1, Y: 0, W: 3, H: 3})
+		m := pimap.New(newTileset(), 2, 2, 1)
+		m.Layers[0].SetAll(
+			1, 2,
+			2, 1,
+		)
+		// when
+		m.Draw(0, 0)
+		// then
+		expected := pi.NewCanvas(4, 4)
+		expected.SetAll(
+			0, 3, 3, 0,
+			0, 2, 2, 0,
+			0, 2, 2, 0,
+			0, 0, 0, 0,
+		)
+		pitest.AssertSurfaceEqual(t, expected, pi.Screen())
+	})
+}
+
+func TestMap_TLine(t *testing.T) {
+	pi.ResetColorTables()
+	pi.Camera = pi.Position{}
+	pi.SetDrawTarget(pi.NewCanvas(8, 1))
+	m := pimap.New(newTileset(), 3, 1, 1)
+	m.Layers[0].SetAll(1, 0, 2)
+	// when
+	m.TLine(0, 0, 0, 7, 0, -1, 0, 1, 0)
+	// then
+	expected := pi.NewCanvas(8, 1)
+	expected.SetAll(0, 2, 2, 0, 0, 3, 3, 0)
+	pitest.AssertSurfaceEqual(t, expected, pi.DrawTarget())
+}
@@ -0,0 +1,114 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pimap
+
+import (
+	"fmt"
+
+	"github.com/elgopher/pi"
+)
+
+// Tileset is a sprite sheet divided into a grid of equally sized tiles.
+//
+// Tiles are numbered from left to right, top to bottom, starting at 0.
+// Each tile can store 8 flags (like Pico-8 sprite flags), which you can
+// use to mark tiles as solid, deadly, collectible, etc.
+type Tileset struct {
+	Source       pi.Canvas
+	TileW, TileH int
+	flags        []uint8
+}
+
+// NewTileset creates a Tileset from the Canvas divided into tiles
+// of the specified size.
+//
+// Incomplete tiles at the right and bottom edges of the canvas are ignored.
+func NewTileset(source pi.Canvas, tileW, tileH int) *Tileset {
+	if tileW <= 0 || tileH <= 0 {
+		panic(fmt.Sprintf("tile size %dx%d must be greater than 0", tileW, tileH))
+	}
+
+	t := &Tileset{
+		Source: source,
+		TileW:  tileW,
+		TileH:  tileH,
+	}
+	t.flags = make([]uint8, t.Len())
+	return t
+}
+
+// Cols returns the number of tiles in a single row of the sprite sheet.
+func (t *Tileset) Cols() int {
+	return t.Source.W() / t.TileW
+}
+
+// Rows returns the number of tile rows in the sprite sheet.
+func (t *Tileset) Rows() int {
+	return t.Source.H() / t.TileH
+}
+
+// Len returns the total number of tiles.
+func (t *Tileset) Len() int {
+	return t.Cols() * t.Rows()
+}
+
+// Sprite returns the sprite for the given tile.
+//
+// For tiles out of range, it returns a sprite with zero size.
+func (t *Tileset) Sprite(tile Tile) pi.Sprite {
+	if int(tile) >= t.Len() {
+		return pi.Sprite{Source: t.Source}
+	}
+
+	cols := t.Cols()
+	return pi.SpriteFrom(t.Source,
+		int(tile)%cols*t.TileW,
+		int(tile)/cols*t.TileH,
+		t.TileW,
+		t.TileH,
+	)
+}
+
+// Flags returns all 8 flags of the tile as a bitfield.
+//
+// Returns 0 for tiles out of range.
+func (t *Tileset) Flags(tile Tile) uint8 {
+	if int(tile) >= len(t.flags) {
+		return 0
+	}
+	return t.flags[tile]
+}
+
+// SetFlags replaces all 8 flags of the tile with the bitfield.
+//
+// Does nothing for tiles out of range.
+func (t *Tileset) SetFlags(tile Tile, flags uint8) {
+	if int(tile) >= len(t.flags) {
+		return
+	}
+	t.flags[tile] = flags
+}
+
+// Flag reports whether the flag (0..7) is set for the tile.
+func (t *Tileset) Flag(tile Tile, flag int) bool {
+	return t.Flags(tile)&flagBit(flag) != 0
+}
+
+// SetFlag sets or clears the flag (0..7) for the tile.
+func (t *Tileset) SetFlag(tile Tile, flag int, v bool) {
+	flags := t.Flags(tile)
+	if v {
+		flags |= flagBit(flag)
+	} else {
+		flags &^= flagBit(flag)
+	}
+	t.SetFlags(tile, flags)
+}
+
+func flagBit(flag int) uint8 {
+	if flag < 0 || flag > 7 {
+		panic(fmt.Sprintf("flag %d must be in range 0..7", flag))
+	}
+	return 1 << flag
+}
@@ -0,0 +1,146 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pimath
+
+import "math"
+
+// Easing maps the progress t (0..1) to the eased progress.
+//
+// Eased progress is 0 for t=0 and 1 for t=1, but can go outside
+// the range 0..1 in between (see EaseInBack or EaseOutElastic).
+//
+// Visual examples of all functions: https://easings.net
+type Easing func(t float64) float64
+
+// Linear returns t unchanged.
+func Linear(t float64) float64 {
+	return t
+}
+
+// EaseInQuad is quadratic easing, which starts slowly and accelerates.
+func EaseInQuad(t float64) float64 {
+	return t * t
+}
+
+// EaseOutQuad is quadratic easing, which starts fast and decelerates.
+func EaseOutQuad(t float64) float64 {
+	return 1 - (1-t)*(1-t)
+}
+
+// EaseInOutQuad is quadratic easing, which accelerates until halfway, then decelerates.
+func EaseInOutQuad(t float64) float64 {
+	if t < 0.5 {
+		return 2 * t * t
+	}
+	return 1 - math.Pow(-2*t+2, 2)/2
+}
+
+// EaseInCubic is cubic easing, which starts slowly and accelerates.
+func EaseInCubic(t float64) float64 {
+	return t * t * t
+}
+
+// EaseOutCubic is cubic easing, which starts fast and decelerates.
+func EaseOutCubic(t float64) float64 {
+	return 1 - math.Pow(1-t, 3)
+}
+
+// EaseInOutCubic is cubic easing, which accelerates until halfway, then decelerates.
+func EaseInOutCubic(t float64) float64 {
+	if t < 0.5 {
+		return 4 * t * t * t
+	}
+	return 1 - math.Pow(-2*t+2, 3)/2
+}
+
+const (
+	backC1 = 1.70158
+	backC2 = backC1 * 1.525
+	backC3 = backC1 + 1
+)
+
+// EaseInBack is back (overshooting) easing, which starts slowly and accelerates.
+func EaseInBack(t float64) float64 {
+	return backC3*t*t*t - backC1*t*t
+}
+
+// EaseOutBack is back (overshooting) easing, which starts fast and decelerates.
+func EaseOutBack(t float64) float64 {
+	return 1 + backC3*math.Pow(t-1, 3) + backC1*math.Pow(t-1, 2)
+}
+
+// EaseInOutBack is back (overshooting) easing, which accelerates until halfway, then decelerates.
+func EaseInOutBack(t float64) float64 {
+	if t < 0.5 {
+		return math.Pow(2*t, 2) * ((backC2+1)*2*t - backC2) / 2
+	}
+	return (math.Pow(2*t-2, 2)*((backC2+1)*(t*2-2)+backC2) + 2) / 2
+}
+
+const (
+	elasticC4 = 2 * math.Pi / 3
+	elasticC5 = 2 * math.Pi / 4.5
+)
+
+// EaseInElastic is elastic easing, which starts slowly and accelerates.
+func EaseInElastic(t float64) float64 {
+	if t == 0 || t == 1 {
+		return t
+	}
+	return -math.Pow(2, 10*t-10) * math.Sin((t*10-10.75)*elasticC4)
+}
+
+// EaseOutElastic is elastic easing, which starts fast and decelerates.
+func EaseOutElastic(t float64) float64 {
+	if t == 0 || t == 1 {
+		return t
+	}
+	return math.Pow(2, -10*t)*math.Sin((t*10-0.75)*elasticC4) + 1
+}
+
+// EaseInOutElastic is elastic easing, which accelerates until halfway, then decelerates.
+func EaseInOutElastic(t float64) float64 {
+	switch {
+	case t == 0 || t == 1:
+		return t
+	case t < 0.5:
+		return -(math.Pow(2, 20*t-10) * math.Sin((20*t-11.125)*elasticC5)) / 2
+	default:
+		return math.Pow(2, -20*t+10)*math.Sin((20*t-11.125)*elasticC5)/2 + 1
+	}
+}
+
+// EaseInBounce is bouncing easing, which starts slowly and accelerates.
+func EaseInBounce(t float64) float64 {
+	return 1 - EaseOutBounce(1-t)
+}
+
+// EaseOutBounce is bouncing easing, which starts fast and decelerates.
+func EaseOutBounce(t float64) float64 {
+	const (
+		n1 = 7.5625
+		d1 = 2.75
+	)
+	switch {
+	case t < 1/d1:
+		return n1 * t * t
+	case t < 2/d1:
+		t -= 1.5 / d1
+		return n1*t*t + 0.75
+	case t < 2.5/d1:
+		t -= 2.25 / d1
+		return n1*t*t + 0.9375
+	default:
+		t -= 2.625 / d1
+		return n1*t*t + 0.984375
+	}
+}
+
+// EaseInOutBounce is bouncing easing, which accelerates until halfway, then decelerates.
+func EaseInOutBounce(t float64) float64 {
+	if t < 0.5 {
+		return (1 - EaseOutBounce(1-2*t)) / 2
+	}
+	return (1 + EaseOutBounce(2*t-1)) / 2
+}
@@ -0,0 +1,59 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pimath_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elgopher/pi/pimath"
+)
+
+var easings = map[string]pimath.Easing{
+	"Linear":           pimath.Linear,
+	"EaseInQuad":       pimath.EaseInQuad,
+	"EaseOutQuad":      pimath.EaseOutQuad,
+	"EaseInOutQuad":    pimath.EaseInOutQuad,
+	"EaseInCubic":      pimath.EaseInCubic,
+	"EaseOutCubic":     pimath.EaseOutCubic,
+	"EaseInOutCubic":   pimath.EaseInOutCubic,
+	"EaseInBack":       pimath.EaseInBack,
+	"EaseOutBack":      pimath.EaseOutBack,
+	"EaseInOutBack":    pimath.EaseInOutBack,
+	"EaseInElastic":    pimath.EaseInElastic,
+	"EaseOutElastic":   pimath.EaseOutElastic,
+	"EaseInOutElastic": pimath.EaseInOutElastic,
+	"EaseInBounce":     pimath.EaseInBounce,
+	"EaseOutBounce":    pimath.EaseOutBounce,
+	"EaseInOutBounce":  pimath.EaseInOutBounce,
+}
+
+func TestEasing(t *testing.T) {
+	for name, easing := range easings {
+		t.Run(name, func(t *testing.T) {
+			assert.InDelta(t, 0, easing(0), 1e-9)
+			assert.InDelta(t, 1, easing(1), 1e-9)
+		})
+	}
+
+	t.Run("in-out functions should be symmetric", func(t *testing.T) {
+		for _, easing := range []pimath.Easing{
+			pimath.EaseInOutQuad, pimath.EaseInOutCubic, pimath.EaseInOutBack,
+			pimath.EaseInOutElastic, pimath.EaseInOutBounce,
+		} {
+			assert.InDelta(t, 0.5, easing(0.5), 1e-9)
+			assert.InDelta(t, 1, easing(0.3)+easing(0.7), 1e-9)
+		}
+	})
+
+	t.Run("should return known values", func(t *testing.T) {
+		assert.InDelta(t, 0.25, pimath.EaseInQuad(0.5), 1e-9)
+		assert.InDelta(t, 0.75, pimath.EaseOutQuad(0.5), 1e-9)
+		assert.InDelta(t, 0.125, pimath.EaseInCubic(0.5), 1e-9)
+		assert.Less(t, pimath.EaseInBack(0.2), 0.0)
+		assert.Greater(t, pimath.EaseOutBack(0.8), 1.0)
+		assert.InDelta(t, 0.765625, pimath.EaseOutBounce(0.5), 1e-9)
+	})
+}
@@ -0,0 +1,191 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pimath
+
+import (
+	"math"
+	"strconv"
+)
+
+// Fixed is a 16.16 fixed-point number, like numbers in Pico-8.
+//
+// The upper 16 bits store the signed integer part and the lower 16 bits
+// store the fraction. The range is -32768..32767.99998 with precision 1/65536.
+//
+// Calculations on Fixed numbers give the same results on every machine,
+// which makes them suitable for deterministic simulations (replays,
+// lockstep multiplayer).
+//
+// Results outside the range wrap around, like integer overflow in Go
+// (for example 32767 + 1 gives -32768). This also applies to FixedFromInt,
+// Mul, Div and Abs.
+//
+// Values can be added, subtracted and compared using Go operators,
+// but must be multiplied and divided using Mul and Div. Fixed satisfies
+// the Number constraint, so it can be used with generic functions like Clamp.
+// Note, that generic code which multiplies values (for example pi.Area.Size)
+// gives wrong results for Fixed.
+type Fixed int32
+
+const (
+	FixedOne  Fixed = 1 << 16
+	FixedPi   Fixed = 205887 // math.Pi rounded to 16.16
+	fixedBits       = 16
+)
+
+// FixedFromInt converts the integer to Fixed.
+//
+// Integers outside the range -32768..32767 wrap around.
+func FixedFromInt(i int) Fixed {
+	return Fixed(i << fixedBits)
+}
+
+// FixedFromFloat converts the float64 to Fixed, rounding to the nearest value.
+func FixedFromFloat(f float64) Fixed {
+	return Fixed(math.Round(f * (1 << fixedBits)))
+}
+
+// Int returns the integer part, rounded down (like math.Floor).
+func (f Fixed) Int() int {
+	return int(f >> fixedBits)
+}
+
+// Float converts the number to float64 without loss of precision.
+func (f Fixed) Float() float64 {
+	return float64(f) / (1 << fixedBits)
+}
+
+// Floor returns the greatest integer value less than or equal to f.
+func (f Fixed) Floor() Fixed {
+	return f &^ (FixedOne - 1)
+}
+
+// Frac returns the fractional part of f (f - f.Floor()), always positive.
+func (f Fixed) Frac() Fixed {
+	return f & (FixedOne - 1)
+}
+
+// Abs returns the absolute value of f.
+//
+// Abs of -32768 is -32768, because 32768 does not fit in Fixed.
+func (f Fixed) Abs() Fixed {
+	if f < 0 {
+		return -f
+	}
+	return f
+}
+
+// Mul returns f*g. The result wraps around when it is outside the range.
+func (f Fixed) Mul(g Fixed) Fixed {
+	return Fixed(int64(f) * int64(g) >> fixedBits)
+}
+
+// Div returns f/g. It panics when g is 0.
+// The result wraps around when it is outside the range.
+func (f Fixed) Div(g Fixed) Fixed {
+	return Fixed((int64(f) << fixedBits) / int64(g))
+}
+
+func (f Fixed) String() string {
+	return strconv.FormatFloat(f.Float(), 'f', -1, 64)
+}
+
+// FixedSqrt returns the square root of f. It returns 0 for negative numbers.
+func FixedSqrt(f Fixed) Fixed {
+	if f <= 0 {
+		return 0
+	}
+	// integer square root of f * 2^16, bit by bit:
+	x := uint64(f) << fixedBits
+	var result uint64
+	bit := uint64(1) << 62
+	for bit > x {
+		bit >>= 2
+	}
+	for bit != 0 {
+		if x >= result+bit {
+			x -= result + bit
+			result = result>>1 + bit
+		} else {
+			result >>= 1
+		}
+		bit >>= 2
+	}
+	return Fixed(result)
+}
+
+const (
+	sinTableBits  = 12
+	sinTableSize  = 1 << sinTableBits // entries per full turn
+	sinQuarter    = sinTableSize / 4  // sinTable stores only the first quarter
+	atanTableSize = 1024              // entries for ratios 0..1
+)
+
+// sinAt returns the sine for the index in 1/sinTableSize of a full turn.
+func sinAt(idx int64) int64 {
+	i := idx & (sinQuarter - 1)
+	switch idx / sinQuarter % 4 {
+	case 0:
+		return int64(sinTable[i])
+	case 1:
+		return int64(sinTable[sinQuarter-i])
+	case 2:
+		return -int64(sinTable[i])
+	default:
+		return -int64(sinTable[sinQuarter-i])
+	}
+}
+
+// FixedSin returns the sine of the angle in radians, using a lookup table.
+func FixedSin(angle Fixed) Fixed {
+	// angle in 1/65536 of a full turn:
+	turn := int64(angle) << fixedBits / int64(2*FixedPi)
+	turn &= 1<<16 - 1
+	const fracBits = 16 - sinTableBits
+	idx := turn >> fracBits
+	frac := turn & (1<<fracBits - 1)
+	a, b := sinAt(idx), sinAt(idx+1)
+	return Fixed(a + (b-a)*frac>>fracBits)
+}
+
+// FixedCos returns the cosine of the angle in radians, using a lookup table.
+func FixedCos(angle Fixed) Fixed {
+	return FixedSin(angle + FixedPi/2)
+}
+
+// FixedAtan2 returns the arc tangent of y/x in radians, in range -π..π,
+// using a lookup table. It works like math.Atan2.
+func FixedAtan2(y, x Fixed) Fixed {
+	if x == 0 && y == 0 {
+		return 0
+	}
+
+	ax, ay := int64(x.Abs()), int64(y.Abs())
+	var angle Fixed
+	if ay <= ax {
+		angle = atanLookup(ay << fixedBits / ax)
+	} else {
+		angle = FixedPi/2 - atanLookup(ax<<fixedBits/ay)
+	}
+
+	if x < 0 {
+		angle = FixedPi - angle
+	}
+	if y < 0 {
+		angle = -angle
+	}
+	return angle
+}
+
+// atanLookup returns atan of the ratio (0..1 in 16.16).
+func atanLookup(ratio int64) Fixed {
+	scaled := ratio * atanTableSize // 16.16 index
+	idx := scaled >> fixedBits
+	if idx >= atanTableSize {
+		return atanTable[atanTableSize]
+	}
+	frac := scaled & (1<<fixedBits - 1)
+	a, b := int64(atanTable[idx]), int64(atanTable[idx+1])
+	return Fixed(a + (b-a)*frac>>fixedBits)
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pimath_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elgopher/pi/pimath"
+)
+
+func fixed(f float64) pimath.Fixed {
+	return pimath.FixedFromFloat(f)
+}
+
+func TestFixed_Conversions(t *testing.T) {
+	assert.Equal(t, pimath.FixedOne*3, pimath.FixedFromInt(3))
+	assert.Equal(t, pimath.Fixed(0x18000), fixed(1.5))
+	assert.Equal(t, 1.5, fixed(1.5).Float())
+	assert.Equal(t, -2.25, fixed(-2.25).Float())
+	assert.Equal(t, 1, fixed(1.75).Int())
+	assert.Equal(t, -2, fixed(-1.25).Int())
+	assert.Equal(t, "-1.25", fixed(-1.25).String())
+	assert.InDelta(t, math.Pi, pimath.FixedPi.Float(), 1.0/65536)
+}
+
+func TestFixed_Arithmetic(t *testing.T) {
+	assert.Equal(t, fixed(3.75), fixed(1.5)+fixed(2.25))
+	assert.Equal(t, fixed(3.375), fixed(1.5).Mul(fixed(2.25)))
+	assert.Equal(t, fixed(-3.375), fixed(-1.5).Mul(fixed(2.25)))
+	assert.Equal(t, fixed(0.75), fixed(1.5).Div(fixed(2)))
+	assert.Equal(t, fixed(-0.75), fixed(1.5).Div(fixed(-2)))
+	assert.Equal(t, fixed(2), fixed(2.75).Floor())
+	assert.Equal(t, fixed(-3), fixed(-2.25).Floor())
+	assert.Equal(t, fixed(0.75), fixed(-2.25).Frac())
+	assert.Equal(t, fixed(2.25), fixed(-2.25).Abs())
+	assert.Panics(t, func() {
+		fixed(1).Div(0)
+	})
+}
+
+func TestFixed_Overflow(t *testing.T) {
+	minFixed := pimath.Fixed(math.MinInt32)
+	assert.Equal(t, pimath.FixedFromInt(-32768), pimath.FixedFromInt(32768))
+	assert.Equal(t, pimath.FixedFromInt(-25536), fixed(200).Mul(fixed(200)))
+	assert.Equal(t, pimath.FixedFromInt(-25536), fixed(20000).Div(fixed(0.5)))
+	assert.Equal(t, minFixed, minFixed.Abs())
+}
+
+func TestFixed_Number(t *testing.T) {
+	assert.Equal(t, fixed(1), pimath.Clamp(fixed(5), fixed(-1), fixed(1)))
+}
+
+func TestFixedSqrt(t *testing.T) {
+	assert.Equal(t, fixed(3), pimath.FixedSqrt(fixed(9)))
+	assert.Equal(t, fixed(1.5), pimath.FixedSqrt(fixed(2.25)))
+	assert.InDelta(t, math.Sqrt(2), pimath.FixedSqrt(fixed(2)).Float(), 1.0/65536)
+	assert.InDelta(t, math.Sqrt(30000), pimath.FixedSqrt(fixed(30000)).Float(), 1.0/65536)
+	assert.Equal(t, pimath.Fixed(0), pimath.FixedSqrt(fixed(-4)))
+}
+
+func TestFixedSin(t *testing.T) {
+	for a := -10.0; a <= 10; a += 0.01 {
+		assert.InDelta(t, math.Sin(a), pimath.FixedSin(fixed(a)).Float(), 0.001, "sin(%f)", a)
+		assert.InDelta(t, math.Cos(a), pimath.FixedCos(fixed(a)).Float(), 0.001, "cos(%f)", a)
+	}
+}
+
+func TestFixedAtan2(t *testing.T) {
+	for a := -math.Pi + 0.01; a < math.Pi; a += 0.01 {
+		for _, length := range []float64{0.5, 1, 100} {
+			y, x := math.Sin(a)*length, math.Cos(a)*length
+			assert.InDelta(t, a, pimath.FixedAtan2(fixed(y), fixed(x)).Float(), 0.001, "angle %f", a)
+		}
+	}
+	assert.Equal(t, pimath.Fixed(0), pimath.FixedAtan2(0, 0))
+	assert.Equal(t, pimath.FixedPi, pimath.FixedAtan2(0, fixed(-1)))
+}
@@ -0,0 +1,56 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pimath
+
+// SegmentIntersection returns the intersection point of segments
+// a0-a1 and b0-b1.
+//
+// It returns false when segments do not intersect or are parallel.
+func SegmentIntersection(a0, a1, b0, b1 Vec2[float64]) (Vec2[float64], bool) {
+	r := a1.Sub(a0)
+	s := b1.Sub(b0)
+	denominator := r.Cross(s)
+	if denominator == 0 {
+		return Vec2[float64]{}, false
+	}
+
+	d := b0.Sub(a0)
+	t := d.Cross(s) / denominator
+	u := d.Cross(r) / denominator
+	if t < 0 || t > 1 || u < 0 || u > 1 {
+		return Vec2[float64]{}, false
+	}
+
+	return a0.Add(r.Scale(t)), true
+}
+
+// CircleRectOverlap reports whether the circle overlaps the rectangle
+// with top-left corner at rectPos and size rectSize.
+//
+// Shapes which only touch each other do not overlap.
+func CircleRectOverlap(center Vec2[float64], radius float64, rectPos, rectSize Vec2[float64]) bool {
+	closest := Vec2[float64]{
+		X: Clamp(center.X, rectPos.X, rectPos.X+rectSize.X),
+		Y: Clamp(center.Y, rectPos.Y, rectPos.Y+rectSize.Y),
+	}
+	d := center.Sub(closest)
+	return d.Dot(d) < radius*radius
+}
+
+// PointInPolygon reports whether the point is inside the polygon,
+// using the even-odd rule.
+//
+// The polygon is closed automatically - the last vertex is connected
+// with the first one.
+func PointInPolygon(p Vec2[float64], polygon []Vec2[float64]) bool {
+	inside := false
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		a, b := polygon[i], polygon[j]
+		if (a.Y > p.Y) != (b.Y > p.Y) &&
+			p.X < (b.X-a.X)*(p.Y-a.Y)/(b.Y-a.Y)+a.X {
+			inside = !inside
+		}
+	}
+	return inside
+}
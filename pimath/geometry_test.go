@@ -0,0 +1,69 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pimath_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elgopher/pi/pimath"
+)
+
+func TestSegmentIntersection(t *testing.T) {
+	tests := map[string]struct {
+		a0, a1, b0, b1 vec
+		expected       vec
+		expectedOk     bool
+	}{
+		"crossing": {
+			a0: vec{X: 0, Y: 0}, a1: vec{X: 4, Y: 4},
+			b0: vec{X: 0, Y: 4}, b1: vec{X: 4, Y: 0},
+			expected: vec{X: 2, Y: 2}, expectedOk: true,
+		},
+		"touching at the end": {
+			a0: vec{X: 0, Y: 0}, a1: vec{X: 2, Y: 0},
+			b0: vec{X: 2, Y: -1}, b1: vec{X: 2, Y: 1},
+			expected: vec{X: 2, Y: 0}, expectedOk: true,
+		},
+		"too short": {
+			a0: vec{X: 0, Y: 0}, a1: vec{X: 1, Y: 0},
+			b0: vec{X: 2, Y: -1}, b1: vec{X: 2, Y: 1},
+		},
+		"parallel": {
+			a0: vec{X: 0, Y: 0}, a1: vec{X: 4, Y: 0},
+			b0: vec{X: 0, Y: 1}, b1: vec{X: 4, Y: 1},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			p, ok := pimath.SegmentIntersection(test.a0, test.a1, test.b0, test.b1)
+			assert.Equal(t, test.expectedOk, ok)
+			assert.Equal(t, test.expected, p)
+		})
+	}
+}
+
+func TestCircleRectOverlap(t *testing.T) {
+	pos := vec{X: 10, Y: 10}
+	size := vec{X: 4, Y: 4}
+	assert.True(t, pimath.CircleRectOverlap(vec{X: 12, Y: 12}, 1, pos, size), "inside")
+	assert.True(t, pimath.CircleRectOverlap(vec{X: 8, Y: 12}, 3, pos, size), "left edge")
+	assert.False(t, pimath.CircleRectOverlap(vec{X: 8, Y: 12}, 2, pos, size), "touching")
+	assert.True(t, pimath.CircleRectOverlap(vec{X: 9, Y: 9}, 1.5, pos, size), "corner")
+	assert.False(t, pimath.CircleRectOverlap(vec{X: 9, Y: 9}, 1.4, pos, size), "near corner")
+}
+
+func TestPointInPolygon(t *testing.T) {
+	// U-shaped polygon
+	polygon := []vec{
+		{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 2}, {X: 2, Y: 2},
+		{X: 2, Y: 0}, {X: 3, Y: 0}, {X: 3, Y: 3}, {X: 0, Y: 3},
+	}
+	assert.True(t, pimath.PointInPolygon(vec{X: 0.5, Y: 0.5}, polygon))
+	assert.True(t, pimath.PointInPolygon(vec{X: 1.5, Y: 2.5}, polygon))
+	assert.False(t, pimath.PointInPolygon(vec{X: 1.5, Y: 1}, polygon))
+	assert.False(t, pimath.PointInPolygon(vec{X: 4, Y: 1}, polygon))
+	assert.False(t, pimath.PointInPolygon(vec{X: 1, Y: 1}, nil))
+}
@@ -22,6 +22,16 @@ type Number interface {
 		~uint | ~byte | ~uint16 | ~uint32 | ~uint64
 }
 
+// FromFloat converts v to the type T. For integer types v is rounded
+// to the nearest integer instead of being truncated.
+func FromFloat[T Number](v float64) T {
+	var one T = 1
+	if one/2 == 0 { // integer type
+		v = math.Round(v)
+	}
+	return T(v)
+}
+
 // Clamp limits the value x to the range [min, max].
 func Clamp[T Number](x, min, max T) T {
 	if x < min {
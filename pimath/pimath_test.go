@@ -44,6 +44,19 @@ func TestClamp(t *testing.T) {
 	}
 }
 
+func TestFromFloat(t *testing.T) {
+	t.Run("should round for integer types", func(t *testing.T) {
+		assert.Equal(t, 3, pimath.FromFloat[int](2.6))
+		assert.Equal(t, -3, pimath.FromFloat[int](-2.6))
+		assert.Equal(t, uint8(2), pimath.FromFloat[uint8](1.5))
+	})
+
+	t.Run("should not round for floating-point types", func(t *testing.T) {
+		assert.InDelta(t, 2.6, pimath.FromFloat[float64](2.6), 0.0001)
+		assert.InDelta(t, 2.6, pimath.FromFloat[float32](2.6), 0.0001)
+	})
+}
+
 func TestLerp(t *testing.T) {
 	tests := map[string]struct {
 		a, b, t  float64
@@ -0,0 +1,144 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pimath
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/bits"
+)
+
+// Rand is a deterministic pseudo-random number generator.
+//
+// The same seed always produces the same sequence of numbers,
+// on every platform (including GOOS=js). This makes it suitable
+// for replays, daily challenges and tests.
+//
+// The state of the generator can be saved using MarshalBinary
+// and restored using UnmarshalBinary.
+//
+// Rand is not suitable for security-sensitive work.
+type Rand struct {
+	state uint64
+}
+
+// NewRand creates a new generator with the given seed.
+func NewRand(seed uint64) *Rand {
+	return &Rand{state: seed}
+}
+
+// Seed resets the generator to the state produced by NewRand(seed).
+func (r *Rand) Seed(seed uint64) {
+	r.state = seed
+}
+
+// Uint64 returns a pseudo-random 64-bit value.
+func (r *Rand) Uint64() uint64 {
+	// SplitMix64 algorithm
+	r.state += 0x9e3779b97f4a7c15
+	z := r.state
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
+
+// Int returns a pseudo-random number in range 0..n-1.
+//
+// It panics if n <= 0.
+func (r *Rand) Int(n int) int {
+	if n <= 0 {
+		panic("invalid argument to Int: n must be greater than 0")
+	}
+	// Lemire's method, which avoids modulo bias:
+	hi, lo := bits.Mul64(r.Uint64(), uint64(n))
+	if lo < uint64(n) {
+		threshold := -uint64(n) % uint64(n)
+		for lo < threshold {
+			hi, lo = bits.Mul64(r.Uint64(), uint64(n))
+		}
+	}
+	return int(hi)
+}
+
+// IntRange returns a pseudo-random number in range min..max, inclusive.
+//
+// It panics if max < min.
+func (r *Rand) IntRange(min, max int) int {
+	if max < min {
+		panic("invalid argument to IntRange: max must not be lower than min")
+	}
+	return min + r.Int(max-min+1)
+}
+
+// Float returns a pseudo-random number in range [0.0, 1.0).
+func (r *Rand) Float() float64 {
+	return float64(r.Uint64()>>11) / (1 << 53)
+}
+
+// Chance returns true with the given probability (0..1).
+//
+// For example, Chance(0.25) returns true in 25% of calls.
+func (r *Rand) Chance(probability float64) bool {
+	return r.Float() < probability
+}
+
+// Weighted returns a pseudo-random index of the weights slice.
+// The probability of each index is proportional to its weight.
+//
+// For example, Weighted(1, 3) returns 1 three times more often than 0.
+// Negative weights are treated as 0. It panics if the sum of weights is 0.
+func (r *Rand) Weighted(weights ...float64) int {
+	total := 0.0
+	for _, w := range weights {
+		total += max(w, 0)
+	}
+	if total <= 0 {
+		panic("invalid argument to Weighted: sum of weights must be greater than 0")
+	}
+
+	x := r.Float() * total
+	last := 0
+	for i, w := range weights {
+		if w <= 0 {
+			continue
+		}
+		if x < w {
+			return i
+		}
+		x -= w
+		last = i
+	}
+	return last // float rounding
+}
+
+// Pick returns a pseudo-random element of the slice.
+//
+// It panics if the slice is empty.
+func Pick[T any](r *Rand, s []T) T {
+	return s[r.Int(len(s))]
+}
+
+// Shuffle randomizes the order of elements in place.
+func Shuffle[T any](r *Rand, s []T) {
+	for i := len(s) - 1; i > 0; i-- {
+		j := r.Int(i + 1)
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+const randStateSize = 8
+
+// MarshalBinary returns the state of the generator.
+func (r *Rand) MarshalBinary() ([]byte, error) {
+	return binary.LittleEndian.AppendUint64(nil, r.state), nil
+}
+
+// UnmarshalBinary restores the state saved by MarshalBinary.
+func (r *Rand) UnmarshalBinary(data []byte) error {
+	if len(data) != randStateSize {
+		return errors.New("invalid Rand state length")
+	}
+	r.state = binary.LittleEndian.Uint64(data)
+	return nil
+}
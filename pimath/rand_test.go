@@ -0,0 +1,130 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pimath_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elgopher/pi/pimath"
+)
+
+func TestRand_Uint64(t *testing.T) {
+	t.Run("should generate the same sequence on every platform", func(t *testing.T) {
+		r := pimath.NewRand(0)
+		assert.Equal(t, uint64(0xe220a8397b1dcdaf), r.Uint64())
+		assert.Equal(t, uint64(0x6e789e6aa1b965f4), r.Uint64())
+		assert.Equal(t, uint64(0x06c45d188009454f), r.Uint64())
+	})
+
+	t.Run("should generate the same sequence after Seed", func(t *testing.T) {
+		r := pimath.NewRand(42)
+		first := r.Uint64()
+		r.Seed(42)
+		assert.Equal(t, first, r.Uint64())
+	})
+}
+
+func TestRand_Int(t *testing.T) {
+	r := pimath.NewRand(1)
+	counts := make([]int, 3)
+	for i := 0; i < 3000; i++ {
+		counts[r.Int(3)]++
+	}
+	for _, c := range counts {
+		assert.InDelta(t, 1000, c, 100)
+	}
+
+	assert.Panics(t, func() {
+		r.Int(0)
+	})
+}
+
+func TestRand_IntRange(t *testing.T) {
+	r := pimath.NewRand(1)
+	seen := map[int]bool{}
+	for i := 0; i < 100; i++ {
+		v := r.IntRange(-2, 2)
+		require.GreaterOrEqual(t, v, -2)
+		require.LessOrEqual(t, v, 2)
+		seen[v] = true
+	}
+	assert.Len(t, seen, 5)
+	assert.Equal(t, 7, r.IntRange(7, 7))
+	assert.Panics(t, func() {
+		r.IntRange(1, 0)
+	})
+}
+
+func TestRand_Float(t *testing.T) {
+	r := pimath.NewRand(1)
+	for i := 0; i < 100; i++ {
+		f := r.Float()
+		require.GreaterOrEqual(t, f, 0.0)
+		require.Less(t, f, 1.0)
+	}
+}
+
+func TestRand_Chance(t *testing.T) {
+	r := pimath.NewRand(1)
+	assert.False(t, r.Chance(0))
+	assert.True(t, r.Chance(1))
+	hits := 0
+	for i := 0; i < 1000; i++ {
+		if r.Chance(0.25) {
+			hits++
+		}
+	}
+	assert.InDelta(t, 250, hits, 50)
+}
+
+func TestRand_Weighted(t *testing.T) {
+	r := pimath.NewRand(1)
+	counts := make([]int, 3)
+	for i := 0; i < 4000; i++ {
+		counts[r.Weighted(1, 0, 3)]++
+	}
+	assert.InDelta(t, 1000, counts[0], 100)
+	assert.Equal(t, 0, counts[1])
+	assert.InDelta(t, 3000, counts[2], 100)
+
+	assert.Panics(t, func() {
+		r.Weighted(0, -1)
+	})
+}
+
+func TestPick(t *testing.T) {
+	r := pimath.NewRand(1)
+	items := []string{"a", "b", "c"}
+	assert.Contains(t, items, pimath.Pick(r, items))
+	assert.Panics(t, func() {
+		pimath.Pick(r, []string{})
+	})
+}
+
+func TestShuffle(t *testing.T) {
+	r := pimath.NewRand(1)
+	items := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	pimath.Shuffle(r, items)
+	assert.ElementsMatch(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, items)
+	assert.NotEqual(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, items)
+}
+
+func TestRand_MarshalBinary(t *testing.T) {
+	r := pimath.NewRand(123)
+	r.Uint64()
+	state, err := r.MarshalBinary()
+	require.NoError(t, err)
+	expected := []int{r.Int(100), r.Int(100), r.Int(100)}
+	// when
+	restored := pimath.NewRand(0)
+	err = restored.UnmarshalBinary(state)
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, expected, []int{restored.Int(100), restored.Int(100), restored.Int(100)})
+
+	assert.Error(t, restored.UnmarshalBinary([]byte{1, 2}))
+}
@@ -0,0 +1,88 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pimath
+
+import "math"
+
+// Vec2 is a 2D vector.
+//
+// Use pi.PositionFromVec2 to convert it to pi.Position.
+type Vec2[T Number] struct{ X, Y T }
+
+// Add returns the sum of both vectors.
+func (v Vec2[T]) Add(other Vec2[T]) Vec2[T] {
+	return Vec2[T]{v.X + other.X, v.Y + other.Y}
+}
+
+// Sub returns the difference of both vectors.
+func (v Vec2[T]) Sub(other Vec2[T]) Vec2[T] {
+	return Vec2[T]{v.X - other.X, v.Y - other.Y}
+}
+
+// Scale returns the vector multiplied by s.
+func (v Vec2[T]) Scale(s T) Vec2[T] {
+	return Vec2[T]{v.X * s, v.Y * s}
+}
+
+// Dot returns the dot product of both vectors.
+func (v Vec2[T]) Dot(other Vec2[T]) T {
+	return v.X*other.X + v.Y*other.Y
+}
+
+// Cross returns the z component of the cross product of both vectors.
+//
+// It is positive when other is clockwise from v (Y axis points down).
+func (v Vec2[T]) Cross(other Vec2[T]) T {
+	return v.X*other.Y - v.Y*other.X
+}
+
+// Length returns the length of the vector.
+func (v Vec2[T]) Length() float64 {
+	x, y := float64(v.X), float64(v.Y)
+	return math.Sqrt(x*x + y*y)
+}
+
+// Normalize returns the vector with the same direction and length 1.
+//
+// The zero vector is returned unchanged. For integer vectors
+// the result is truncated.
+func (v Vec2[T]) Normalize() Vec2[T] {
+	length := v.Length()
+	if length == 0 {
+		return v
+	}
+	return Vec2[T]{T(float64(v.X) / length), T(float64(v.Y) / length)}
+}
+
+// Rotate returns the vector rotated by the angle in radians.
+//
+// The vector is rotated clockwise, because the Y axis points down.
+// For integer vectors the result is rounded.
+func (v Vec2[T]) Rotate(angle float64) Vec2[T] {
+	sin, cos := math.Sincos(angle)
+	x, y := float64(v.X), float64(v.Y)
+	return Vec2[T]{FromFloat[T](x*cos - y*sin), FromFloat[T](x*sin + y*cos)}
+}
+
+// Angle returns the angle of the vector in radians, in range -π..π.
+//
+// Angle 0 points right, angle π/2 points down.
+func (v Vec2[T]) Angle() float64 {
+	return math.Atan2(float64(v.Y), float64(v.X))
+}
+
+// Lerp computes the linear interpolation between v and other.
+//
+// The parameter t should be in the range 0 to 1.
+func (v Vec2[T]) Lerp(other Vec2[T], t float64) Vec2[T] {
+	return Vec2[T]{
+		T(Lerp(float64(v.X), float64(other.X), t)),
+		T(Lerp(float64(v.Y), float64(other.Y), t)),
+	}
+}
+
+// Distance returns the distance between v and other.
+func (v Vec2[T]) Distance(other Vec2[T]) float64 {
+	return Distance(float64(v.X), float64(v.Y), float64(other.X), float64(other.Y))
+}
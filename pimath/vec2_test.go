@@ -0,0 +1,55 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pimath_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elgopher/pi/pimath"
+)
+
+type vec = pimath.Vec2[float64]
+
+func TestVec2_Arithmetic(t *testing.T) {
+	a := pimath.Vec2[int]{X: 1, Y: 2}
+	b := pimath.Vec2[int]{X: 3, Y: -4}
+	assert.Equal(t, pimath.Vec2[int]{X: 4, Y: -2}, a.Add(b))
+	assert.Equal(t, pimath.Vec2[int]{X: -2, Y: 6}, a.Sub(b))
+	assert.Equal(t, pimath.Vec2[int]{X: 3, Y: 6}, a.Scale(3))
+	assert.Equal(t, -5, a.Dot(b))
+	assert.Equal(t, -10, a.Cross(b))
+	assert.Equal(t, 5.0, b.Length())
+	assert.Equal(t, 5.0, pimath.Vec2[int]{}.Distance(b))
+}
+
+func TestVec2_Normalize(t *testing.T) {
+	assert.Equal(t, vec{X: 0.6, Y: -0.8}, vec{X: 3, Y: -4}.Normalize())
+	assert.Equal(t, vec{}, vec{}.Normalize())
+	assert.Equal(t, pimath.Vec2[int]{X: 1}, pimath.Vec2[int]{X: 5}.Normalize())
+}
+
+func TestVec2_Rotate(t *testing.T) {
+	t.Run("float", func(t *testing.T) {
+		rotated := vec{X: 2, Y: 0}.Rotate(math.Pi / 2)
+		assert.InDelta(t, 0, rotated.X, 1e-9)
+		assert.InDelta(t, 2, rotated.Y, 1e-9)
+	})
+
+	t.Run("int", func(t *testing.T) {
+		rotated := pimath.Vec2[int]{X: 2, Y: 1}.Rotate(math.Pi)
+		assert.Equal(t, pimath.Vec2[int]{X: -2, Y: -1}, rotated)
+	})
+}
+
+func TestVec2_Angle(t *testing.T) {
+	assert.Equal(t, 0.0, vec{X: 1}.Angle())
+	assert.Equal(t, math.Pi/2, vec{Y: 1}.Angle())
+}
+
+func TestVec2_Lerp(t *testing.T) {
+	assert.Equal(t, vec{X: 1, Y: 2}, vec{}.Lerp(vec{X: 4, Y: 8}, 0.25))
+}
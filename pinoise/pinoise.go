@@ -0,0 +1,303 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+// Package pinoise provides seedable coherent noise (Perlin and value noise)
+// for generating procedural content such as terrain, clouds or wobbly effects.
+//
+// All noise functions return values approximately in range -1..1
+// and do not allocate.
+package pinoise
+
+import (
+	"math"
+
+	"github.com/elgopher/pi"
+	"github.com/elgopher/pi/pimath"
+)
+
+// Noise generates noise for the seed passed to New.
+//
+// Octaves, Lacunarity and Gain are used by FBM functions, which sum
+// multiple layers (octaves) of Perlin noise - each octave has
+// the frequency multiplied by Lacunarity and the amplitude multiplied by Gain.
+type Noise struct {
+	Octaves    int
+	Lacunarity float64
+	Gain       float64
+
+	perm [512]uint8
+}
+
+// New creates Noise for the given seed with 4 octaves,
+// lacunarity 2 and gain 0.5.
+func New(seed uint64) *Noise {
+	n := &Noise{
+		Octaves:    4,
+		Lacunarity: 2,
+		Gain:       0.5,
+	}
+
+	var p [256]uint8
+	for i := range p {
+		p[i] = uint8(i)
+	}
+	pimath.Shuffle(pimath.NewRand(seed), p[:])
+	copy(n.perm[:256], p[:])
+	copy(n.perm[256:], p[:])
+
+	return n
+}
+
+// Perlin1 returns 1D Perlin noise at x.
+func (n *Noise) Perlin1(x float64) float64 {
+	xf := math.Floor(x)
+	xi := int(xf) & 255
+	x -= xf
+
+	a := grad1(n.perm[xi], x)
+	b := grad1(n.perm[xi+1], x-1)
+	return pimath.Lerp(a, b, fade(x))
+}
+
+// Perlin2 returns 2D Perlin noise at (x, y).
+func (n *Noise) Perlin2(x, y float64) float64 {
+	xf, yf := math.Floor(x), math.Floor(y)
+	xi, yi := int(xf)&255, int(yf)&255
+	x, y = x-xf, y-yf
+	u, v := fade(x), fade(y)
+
+	p := &n.perm
+	aa := p[int(p[xi])+yi]
+	ab := p[int(p[xi])+yi+1]
+	ba := p[int(p[xi+1])+yi]
+	bb := p[int(p[xi+1])+yi+1]
+
+	return pimath.Lerp(
+		pimath.Lerp(grad2(aa, x, y), grad2(ba, x-1, y), u),
+		pimath.Lerp(grad2(ab, x, y-1), grad2(bb, x-1, y-1), u),
+		v,
+	)
+}
+
+// Perlin3 returns 3D Perlin noise at (x, y, z).
+//
+// z is often used as time to animate 2D noise.
+func (n *Noise) Perlin3(x, y, z float64) float64 {
+	xf, yf, zf := math.Floor(x), math.Floor(y), math.Floor(z)
+	xi, yi, zi := int(xf)&255, int(yf)&255, int(zf)&255
+	x, y, z = x-xf, y-yf, z-zf
+	u, v, w := fade(x), fade(y), fade(z)
+
+	p := &n.perm
+	a := int(p[xi]) + yi
+	aa := int(p[a]) + zi
+	ab := int(p[a+1]) + zi
+	b := int(p[xi+1]) + yi
+	ba := int(p[b]) + zi
+	bb := int(p[b+1]) + zi
+
+	return pimath.Lerp(
+		pimath.Lerp(
+			pimath.Lerp(grad3(p[aa], x, y, z), grad3(p[ba], x-1, y, z), u),
+			pimath.Lerp(grad3(p[ab], x, y-1, z), grad3(p[bb], x-1, y-1, z), u),
+			v),
+		pimath.Lerp(
+			pimath.Lerp(grad3(p[aa+1], x, y, z-1), grad3(p[ba+1], x-1, y, z-1), u),
+			pimath.Lerp(grad3(p[ab+1], x, y-1, z-1), grad3(p[bb+1], x-1, y-1, z-1), u),
+			v),
+		w,
+	)
+}
+
+// Value1 returns 1D value noise at x.
+//
+// Value noise is cheaper than Perlin noise, but looks more blocky.
+func (n *Noise) Value1(x float64) float64 {
+	xf := math.Floor(x)
+	xi := int(xf) & 255
+	return pimath.Lerp(value(n.perm[xi]), value(n.perm[xi+1]), fade(x-xf))
+}
+
+// Value2 returns 2D value noise at (x, y).
+func (n *Noise) Value2(x, y float64) float64 {
+	xf, yf := math.Floor(x), math.Floor(y)
+	xi, yi := int(xf)&255, int(yf)&255
+	u, v := fade(x-xf), fade(y-yf)
+
+	p := &n.perm
+	return pimath.Lerp(
+		pimath.Lerp(value(p[int(p[xi])+yi]), value(p[int(p[xi+1])+yi]), u),
+		pimath.Lerp(value(p[int(p[xi])+yi+1]), value(p[int(p[xi+1])+yi+1]), u),
+		v,
+	)
+}
+
+// Value3 returns 3D value noise at (x, y, z).
+func (n *Noise) Value3(x, y, z float64) float64 {
+	xf, yf, zf := math.Floor(x), math.Floor(y), math.Floor(z)
+	xi, yi, zi := int(xf)&255, int(yf)&255, int(zf)&255
+	u, v, w := fade(x-xf), fade(y-yf), fade(z-zf)
+
+	p := &n.perm
+	corner := func(dx, dy, dz int) float64 {
+		return value(p[int(p[int(p[xi+dx])+yi+dy])+zi+dz])
+	}
+
+	return pimath.Lerp(
+		pimath.Lerp(
+			pimath.Lerp(corner(0, 0, 0), corner(1, 0, 0), u),
+			pimath.Lerp(corner(0, 1, 0), corner(1, 1, 0), u),
+			v),
+		pimath.Lerp(
+			pimath.Lerp(corner(0, 0, 1), corner(1, 0, 1), u),
+			pimath.Lerp(corner(0, 1, 1), corner(1, 1, 1), u),
+			v),
+		w,
+	)
+}
+
+// FBM1 returns 1D fractal Brownian motion - the sum of Octaves layers of Perlin1.
+func (n *Noise) FBM1(x float64) float64 {
+	sum, amplitude, total := 0.0, 1.0, 0.0
+	for i := 0; i < max(n.Octaves, 1); i++ {
+		sum += n.Perlin1(x) * amplitude
+		total += amplitude
+		x *= n.Lacunarity
+		amplitude *= n.Gain
+	}
+	return sum / total
+}
+
+// FBM2 returns 2D fractal Brownian motion - the sum of Octaves layers of Perlin2.
+func (n *Noise) FBM2(x, y float64) float64 {
+	sum, amplitude, total := 0.0, 1.0, 0.0
+	for i := 0; i < max(n.Octaves, 1); i++ {
+		sum += n.Perlin2(x, y) * amplitude
+		total += amplitude
+		x *= n.Lacunarity
+		y *= n.Lacunarity
+		amplitude *= n.Gain
+	}
+	return sum / total
+}
+
+// FBM3 returns 3D fractal Brownian motion - the sum of Octaves layers of Perlin3.
+func (n *Noise) FBM3(x, y, z float64) float64 {
+	sum, amplitude, total := 0.0, 1.0, 0.0
+	for i := 0; i < max(n.Octaves, 1); i++ {
+		sum += n.Perlin3(x, y, z) * amplitude
+		total += amplitude
+		x *= n.Lacunarity
+		y *= n.Lacunarity
+		z *= n.Lacunarity
+		amplitude *= n.Gain
+	}
+	return sum / total
+}
+
+// Fill sets each value of the surface to noise(x*scale, y*scale),
+// for example:
+//
+//	pinoise.Fill(heightMap, 0.05, noise.FBM2)
+func Fill(s pi.Surface[float64], scale float64, noise func(x, y float64) float64) {
+	for pos, line := range s.LinesIterator(s.EntireArea()) {
+		y := float64(pos.Y) * scale
+		for i := range line {
+			line[i] = noise(float64(i)*scale, y)
+		}
+	}
+}
+
+// FillCanvas is like Fill, but maps noise values to colors.
+//
+// The range -1..1 is divided into len(colors) equal parts, so the first
+// color is used for the lowest values and the last color for the highest.
+// It does nothing when colors are not provided.
+func FillCanvas(c pi.Canvas, scale float64, noise func(x, y float64) float64, colors ...pi.Color) {
+	if len(colors) == 0 {
+		return
+	}
+	for pos, line := range c.LinesIterator(c.EntireArea()) {
+		y := float64(pos.Y) * scale
+		for i := range line {
+			line[i] = ColorOf(noise(float64(i)*scale, y), colors...)
+		}
+	}
+}
+
+// ColorOf maps the noise value (-1..1) to one of the colors.
+// Values outside the range are clamped.
+//
+// It panics when colors are not provided.
+func ColorOf(value float64, colors ...pi.Color) pi.Color {
+	t := (value + 1) / 2
+	idx := pimath.Clamp(int(t*float64(len(colors))), 0, len(colors)-1)
+	return colors[idx]
+}
+
+// fade is the Perlin's smootherstep curve 6t^5-15t^4+10t^3.
+func fade(t float64) float64 {
+	return t * t * t * (t*(t*6-15) + 10)
+}
+
+func value(hash uint8) float64 {
+	return float64(hash)/127.5 - 1
+}
+
+func grad1(hash uint8, x float64) float64 {
+	g := float64(hash&7+1) / 8 // gradients -1..1 with different lengths
+	if hash&8 != 0 {
+		g = -g
+	}
+	return g * x * 2
+}
+
+func grad2(hash uint8, x, y float64) float64 {
+	switch hash & 7 {
+	case 0:
+		return x + y
+	case 1:
+		return -x + y
+	case 2:
+		return x - y
+	case 3:
+		return -x - y
+	case 4:
+		return x
+	case 5:
+		return -x
+	case 6:
+		return y
+	default:
+		return -y
+	}
+}
+
+func grad3(hash uint8, x, y, z float64) float64 {
+	switch hash & 15 {
+	case 0, 12:
+		return x + y
+	case 1, 14:
+		return -x + y
+	case 2:
+		return x - y
+	case 3:
+		return -x - y
+	case 4:
+		return x + z
+	case 5:
+		return -x + z
+	case 6:
+		return x - z
+	case 7:
+		return -x - z
+	case 8:
+		return y + z
+	case 9, 13:
+		return -y + z
+	case 10:
+		return y - z
+	default:
+		return -y - z
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pinoise_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elgopher/pi"
+	"github.com/elgopher/pi/pinoise"
+)
+
+// noiseFunctions returns all noise functions as 3D functions.
+func noiseFunctions(n *pinoise.Noise) map[string]func(x, y, z float64) float64 {
+	return map[string]func(x, y, z float64) float64{
+		"Perlin1": func(x, _, _ float64) float64 { return n.Perlin1(x) },
+		"Perlin2": func(x, y, _ float64) float64 { return n.Perlin2(x, y) },
+		"Perlin3": n.Perlin3,
+		"Value1":  func(x, _, _ float64) float64 { return n.Value1(x) },
+		"Value2":  func(x, y, _ float64) float64 { return n.Value2(x, y) },
+		"Value3":  n.Value3,
+		"FBM1":    func(x, _, _ float64) float64 { return n.FBM1(x) },
+		"FBM2":    func(x, y, _ float64) float64 { return n.FBM2(x, y) },
+		"FBM3":    n.FBM3,
+	}
+}
+
+func TestNoise(t *testing.T) {
+	n := pinoise.New(1)
+	other := pinoise.New(2)
+	otherFunctions := noiseFunctions(other)
+
+	for name, noise := range noiseFunctions(n) {
+		t.Run(name, func(t *testing.T) {
+			differentSeedDiffers := false
+			minValue, maxValue := math.Inf(1), math.Inf(-1)
+
+			for i := 0; i < 1000; i++ {
+				x, y, z := float64(i)*0.37-100, float64(i)*0.53, float64(i)*-0.21
+				v := noise(x, y, z)
+				require.GreaterOrEqual(t, v, -1.0)
+				require.LessOrEqual(t, v, 1.0)
+				minValue, maxValue = min(minValue, v), max(maxValue, v)
+				// same seed gives the same value:
+				require.Equal(t, v, noiseFunctions(n)[name](x, y, z))
+				// noise is continuous:
+				require.InDelta(t, v, noise(x+0.001, y+0.001, z+0.001), 0.05)
+
+				if otherFunctions[name](x, y, z) != v {
+					differentSeedDiffers = true
+				}
+			}
+
+			assert.True(t, differentSeedDiffers)
+			assert.Greater(t, maxValue-minValue, 0.5, "noise should vary")
+		})
+	}
+}
+
+func TestNoise_Perlin2(t *testing.T) {
+	n := pinoise.New(1)
+	t.Run("should be zero at integer coordinates", func(t *testing.T) {
+		assert.Equal(t, 0.0, n.Perlin2(3, -7))
+	})
+
+	t.Run("should not allocate", func(t *testing.T) {
+		allocs := testing.AllocsPerRun(100, func() {
+			n.Perlin2(1.5, 2.5)
+			n.Value3(1.5, 2.5, 3.5)
+			n.FBM3(1.5, 2.5, 3.5)
+		})
+		assert.Zero(t, allocs)
+	})
+}
+
+func TestFill(t *testing.T) {
+	s := pi.NewSurface[float64](3, 2)
+	// when
+	pinoise.Fill(s, 0.5, func(x, y float64) float64 {
+		return x + 10*y
+	})
+	// then
+	assert.Equal(t, []float64{0, 0.5, 1, 5, 5.5, 6}, s.Data())
+}
+
+func TestFillCanvas(t *testing.T) {
+	c := pi.NewCanvas(5, 1)
+	// when
+	pinoise.FillCanvas(c, 1, func(x, _ float64) float64 {
+		return x/2 - 1 // -1, -0.5, 0, 0.5, 1
+	}, 7, 8)
+	// then
+	assert.Equal(t, []pi.Color{7, 7, 8, 8, 8}, c.Data())
+}
+
+func TestColorOf(t *testing.T) {
+	assert.Equal(t, pi.Color(1), pinoise.ColorOf(-5, 1, 2, 3))
+	assert.Equal(t, pi.Color(2), pinoise.ColorOf(0, 1, 2, 3))
+	assert.Equal(t, pi.Color(3), pinoise.ColorOf(5, 1, 2, 3))
+}
@@ -0,0 +1,141 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+// Package pitween animates numeric values over time (tweening).
+//
+// A Tween changes the value of a variable from one value to another
+// over a number of ticks, using an easing function from pimath.
+// Tweens can be used as piroutine steps, so animations can be chained:
+//
+//	routine := piroutine.New(
+//		pitween.New(&x, 0, 100, pianim.Seconds(0.5)).Update,
+//		pitween.New(&y, 0, 50, 10).Update,
+//	)
+package pitween
+
+import (
+	"github.com/elgopher/pi/pievent"
+	"github.com/elgopher/pi/piloop"
+	"github.com/elgopher/pi/pimath"
+	"github.com/elgopher/pi/piroutine"
+)
+
+// Tween animates the value pointed by Target from From to To.
+type Tween[T pimath.Number] struct {
+	Target   *T
+	From, To T
+	// Duration is the number of ticks needed to go from From to To.
+	// Use pianim.Seconds to convert seconds.
+	Duration int
+	// Easing is the easing function. nil means pimath.Linear.
+	Easing pimath.Easing
+	// Yoyo makes the tween go back from To to From after reaching To.
+	// Going back takes another Duration ticks.
+	Yoyo bool
+	// Repeat is the number of additional repetitions. -1 repeats forever.
+	Repeat int
+	// OnComplete is called when the tween has finished. Can be nil.
+	OnComplete func()
+
+	tick       int
+	repetition int
+	finished   bool
+}
+
+// New creates a Tween animating the target from from to to
+// over the given number of ticks.
+func New[T pimath.Number](target *T, from, to T, duration int) *Tween[T] {
+	return &Tween[T]{
+		Target:   target,
+		From:     from,
+		To:       to,
+		Duration: duration,
+	}
+}
+
+// Update advances the tween by one tick and updates the target value.
+//
+// It returns true when the tween has finished, so it can be used
+// as a piroutine.Step.
+func (t *Tween[T]) Update() bool {
+	if t.finished {
+		return true
+	}
+
+	t.tick++
+
+	cycle := max(t.Duration, 1)
+	if t.Yoyo {
+		cycle *= 2
+	}
+	t.setValue(t.progress())
+
+	if t.tick < cycle {
+		return false
+	}
+
+	if t.Repeat < 0 || t.repetition < t.Repeat {
+		t.repetition++
+		t.tick = 0
+		return false
+	}
+
+	t.finished = true
+	if t.OnComplete != nil {
+		t.OnComplete()
+	}
+	return true
+}
+
+// progress returns the progress (0..1) for the current tick, before easing.
+func (t *Tween[T]) progress() float64 {
+	if t.Duration <= 0 {
+		if t.Yoyo && t.tick == 2 {
+			return 0
+		}
+		return 1
+	}
+	if t.tick > t.Duration { // going back in yoyo mode
+		return float64(2*t.Duration-t.tick) / float64(t.Duration)
+	}
+	return float64(t.tick) / float64(t.Duration)
+}
+
+func (t *Tween[T]) setValue(progress float64) {
+	easing := t.Easing
+	if easing == nil {
+		easing = pimath.Linear
+	}
+	v := pimath.Lerp(float64(t.From), float64(t.To), easing(progress))
+	*t.Target = pimath.FromFloat[T](v)
+}
+
+// Step returns the tween as a piroutine.Step.
+func (t *Tween[T]) Step() piroutine.Step {
+	return t.Update
+}
+
+// Finished reports whether the tween has finished.
+func (t *Tween[T]) Finished() bool {
+	return t.finished
+}
+
+// Reset restarts the tween from the beginning. The target value
+// is not changed until the next Update.
+func (t *Tween[T]) Reset() {
+	t.tick = 0
+	t.repetition = 0
+	t.finished = false
+}
+
+// ScheduleOn schedules the tween to update on the given event,
+// usually piloop.EventUpdate.
+//
+// The handler is automatically unsubscribed when the tween has finished.
+func (t *Tween[T]) ScheduleOn(event piloop.Event) pievent.Handler {
+	return piloop.Target().Subscribe(event, func(_ piloop.Event, handler pievent.Handler) {
+		if t.Update() {
+			piloop.Target().Unsubscribe(handler)
+		}
+	})
+}
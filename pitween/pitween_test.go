@@ -0,0 +1,122 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pitween_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elgopher/pi/piloop"
+	"github.com/elgopher/pi/pimath"
+	"github.com/elgopher/pi/piroutine"
+	"github.com/elgopher/pi/pitween"
+)
+
+// updates calls Update until the tween finishes and returns all target values.
+func updates[T pimath.Number](tween *pitween.Tween[T]) []T {
+	var values []T
+	for i := 0; i < 100; i++ {
+		finished := tween.Update()
+		values = append(values, *tween.Target)
+		if finished {
+			break
+		}
+	}
+	return values
+}
+
+func TestTween_Update(t *testing.T) {
+	t.Run("should animate float64", func(t *testing.T) {
+		var x float64
+		tween := pitween.New(&x, 10, 20, 4)
+		assert.Equal(t, []float64{12.5, 15, 17.5, 20}, updates(tween))
+		assert.True(t, tween.Finished())
+	})
+
+	t.Run("should animate int with rounding", func(t *testing.T) {
+		var x int
+		tween := pitween.New(&x, 0, 10, 3)
+		assert.Equal(t, []int{3, 7, 10}, updates(tween))
+	})
+
+	t.Run("should use easing", func(t *testing.T) {
+		var x float64
+		tween := pitween.New(&x, 0, 100, 2)
+		tween.Easing = pimath.EaseInQuad
+		assert.Equal(t, []float64{25, 100}, updates(tween))
+	})
+
+	t.Run("should go back in yoyo mode", func(t *testing.T) {
+		var x int
+		tween := pitween.New(&x, 0, 4, 2)
+		tween.Yoyo = true
+		assert.Equal(t, []int{2, 4, 2, 0}, updates(tween))
+	})
+
+	t.Run("should repeat", func(t *testing.T) {
+		var x int
+		tween := pitween.New(&x, 0, 2, 2)
+		tween.Repeat = 2
+		assert.Equal(t, []int{1, 2, 1, 2, 1, 2}, updates(tween))
+	})
+
+	t.Run("should repeat forever", func(t *testing.T) {
+		var x int
+		tween := pitween.New(&x, 0, 2, 2)
+		tween.Repeat = -1
+		assert.Len(t, updates(tween), 100)
+		assert.False(t, tween.Finished())
+	})
+
+	t.Run("should set the final value immediately for zero duration", func(t *testing.T) {
+		var x int
+		tween := pitween.New(&x, 0, 5, 0)
+		assert.Equal(t, []int{5}, updates(tween))
+	})
+
+	t.Run("should call OnComplete once", func(t *testing.T) {
+		var x float64
+		completed := 0
+		tween := pitween.New(&x, 0, 1, 1)
+		tween.OnComplete = func() { completed++ }
+		tween.Update()
+		tween.Update()
+		assert.Equal(t, 1, completed)
+	})
+
+	t.Run("should start again after Reset", func(t *testing.T) {
+		var x int
+		tween := pitween.New(&x, 0, 2, 2)
+		updates(tween)
+		tween.Reset()
+		assert.False(t, tween.Finished())
+		assert.Equal(t, []int{1, 2}, updates(tween))
+	})
+}
+
+func TestTween_Step(t *testing.T) {
+	var x, y int
+	routine := piroutine.New(
+		pitween.New(&x, 0, 2, 2).Step(),
+		pitween.New(&y, 0, 1, 1).Step(),
+	)
+	routine.Resume()
+	assert.Equal(t, 1, x)
+	routine.Resume() // x finished, y started in the same resume
+	assert.Equal(t, 2, x)
+	assert.Equal(t, 1, y)
+	assert.True(t, routine.Stopped())
+}
+
+func TestTween_ScheduleOn(t *testing.T) {
+	var x int
+	tween := pitween.New(&x, 0, 2, 2)
+	handler := tween.ScheduleOn(piloop.EventUpdate)
+	piloop.Target().Publish(piloop.EventUpdate)
+	assert.Equal(t, 1, x)
+	piloop.Target().Publish(piloop.EventUpdate)
+	assert.Equal(t, 2, x)
+	assert.False(t, piloop.Target().IsSubscribed(handler))
+}
@@ -3,6 +3,12 @@
 
 package pi
 
+import (
+	"math"
+
+	"github.com/elgopher/pi/pimath"
+)
+
 // Position represents a 2D integer coordinate.
 //
 // It stores X and Y values in a 2D grid.
@@ -29,3 +35,19 @@ func (p Position) WithY(y int) Position {
 	p.Y = y
 	return p
 }
+
+// Vec2 converts the Position to pimath.Vec2.
+func (p Position) Vec2() pimath.Vec2[float64] {
+	return pimath.Vec2[float64]{X: float64(p.X), Y: float64(p.Y)}
+}
+
+// PositionFromVec2 converts the vector to Position.
+//
+// Floating-point coordinates are rounded down, so the Position
+// is the pixel containing the point.
+func PositionFromVec2[T pimath.Number](v pimath.Vec2[T]) Position {
+	return Position{
+		X: int(math.Floor(float64(v.X))),
+		Y: int(math.Floor(float64(v.Y))),
+	}
+}
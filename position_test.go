@@ -0,0 +1,22 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pi_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elgopher/pi"
+	"github.com/elgopher/pi/pimath"
+)
+
+func TestPosition_Vec2(t *testing.T) {
+	assert.Equal(t, pimath.Vec2[float64]{X: 1, Y: -2}, pi.Position{X: 1, Y: -2}.Vec2())
+}
+
+func TestPositionFromVec2(t *testing.T) {
+	assert.Equal(t, pi.Position{X: 1, Y: -3}, pi.PositionFromVec2(pimath.Vec2[float64]{X: 1.9, Y: -2.1}))
+	assert.Equal(t, pi.Position{X: 4, Y: 5}, pi.PositionFromVec2(pimath.Vec2[int]{X: 4, Y: 5}))
+}
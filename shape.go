@@ -3,12 +3,16 @@
 
 package pi
 
-import "math"
+import (
+	"math"
+	"math/bits"
+	"slices"
+)
 
 // Rect draws the outline of a rectangle between (x0, y0) and (x1, y1), inclusive.
 //
 // It takes into account the camera position, clipping region,
-// color tables, and masks.
+// color tables, masks, and fill pattern.
 func Rect(x0, y0, x1, y1 int) {
 	// Optimize - run vertical and horizontal line functions directly
 	Line(x0, y0, x1, y0) // horizontal line top
@@ -20,7 +24,7 @@ func Rect(x0, y0, x1, y1 int) {
 // RectFill draws a filled rectangle between (x0, y0) and (x1, y1), inclusive.
 //
 // It takes into account the camera position, clipping region,
-// color tables, and masks.
+// color tables, masks, and fill pattern.
 func RectFill(x0 int, y0 int, x1 int, y1 int) {
 	if x0 > x1 {
 		x0, x1 = x1, x0
@@ -41,10 +45,29 @@ func RectFill(x0 int, y0 int, x1 int, y1 int) {
 
 	currentColor := GetColor() & ReadMask
 
-	for _, line := range DrawTarget().LinesIterator(area) {
+	if fillPattern.Bits == ([8]uint8{}) {
+		for _, line := range DrawTarget().LinesIterator(area) {
+			for i := 0; i < len(line); i++ {
+				target := line[i]
+				line[i] = ColorTables[(currentColor|target)>>6][currentColor&(MaxColors-1)][target&(MaxColors-1)]
+			}
+		}
+		return
+	}
+
+	secondaryColor := fillPattern.Color & ReadMask
+
+	for pos, line := range DrawTarget().LinesIterator(area) {
 		for i := 0; i < len(line); i++ {
+			c := currentColor
+			if patternBit(pos.X+i, pos.Y) {
+				if fillPattern.Transparent {
+					continue
+				}
+				c = secondaryColor
+			}
 			target := line[i]
-			line[i] = ColorTables[(currentColor|target)>>6][currentColor&(MaxColors-1)][target&(MaxColors-1)]
+			line[i] = ColorTables[(c|target)>>6][c&(MaxColors-1)][target&(MaxColors-1)]
 		}
 	}
 }
@@ -52,7 +75,7 @@ func RectFill(x0 int, y0 int, x1 int, y1 int) {
 // Line draws a line on the screen between (x0, y0) and (x1, y1), inclusive.
 //
 // It takes into account the camera position, clipping region,
-// color tables, and masks.
+// color tables, masks, and fill pattern.
 func Line(x0, y0, x1, y1 int) {
 	draw := drawColor & ReadMask
 	// Optimize - add vertical and horizontal line functions
@@ -112,31 +135,39 @@ func Line(x0, y0, x1, y1 int) {
 // Circ draws the outline of a circle with center at (cx, cy) and radius r.
 //
 // It takes into account the camera position, clipping region,
-// color tables, and masks.
+// color tables, masks, and fill pattern.
 func Circ(cx, cy, r int) {
 	draw := drawColor & ReadMask
 
+	circlePixels(r, func(dx, dy int) {
+		setPixelWithColor(cx+dx, cy+dy, draw)
+	})
+}
+
+// circlePixels calls pixel for each pixel of the circle outline, exactly once.
+// dx and dy are relative to the circle center.
+func circlePixels(r int, pixel func(dx, dy int)) {
 	x := 0
 	y := r
 	d := 3 - 2*r
 
 	for x <= y {
 		if x == 0 {
-			setPixelWithColor(cx+y, cy, draw)
-			setPixelWithColor(cx-y, cy, draw)
-			setPixelWithColor(cx, cy+y, draw)
-			setPixelWithColor(cx, cy-y, draw)
+			pixel(y, 0)
+			pixel(-y, 0)
+			pixel(0, y)
+			pixel(0, -y)
 		} else {
-			setPixelWithColor(cx+x, cy+y, draw)
-			setPixelWithColor(cx-x, cy+y, draw)
-			setPixelWithColor(cx+x, cy-y, draw)
-			setPixelWithColor(cx-x, cy-y, draw)
+			pixel(x, y)
+			pixel(-x, y)
+			pixel(x, -y)
+			pixel(-x, -y)
 
 			if x != y {
-				setPixelWithColor(cx+y, cy+x, draw)
-				setPixelWithColor(cx-y, cy+x, draw)
-				setPixelWithColor(cx+y, cy-x, draw)
-				setPixelWithColor(cx-y, cy-x, draw)
+				pixel(y, x)
+				pixel(-y, x)
+				pixel(y, -x)
+				pixel(-y, -x)
 			}
 		}
 
@@ -157,8 +188,16 @@ func horizontalLine(x0, x1, y int) {
 // CircFill draws a filled circle with center at (centerX, centerY) and the given radius.
 //
 // It takes into account the camera position, clipping region,
-// color tables, and masks.
+// color tables, masks, and fill pattern.
 func CircFill(centerX, centerY, radius int) {
+	circleLines(radius, func(x0, x1, dy int) {
+		horizontalLine(centerX+x0, centerX+x1, centerY+dy)
+	})
+}
+
+// circleLines calls line for each horizontal line of the filled circle, exactly once.
+// x0, x1 and dy are relative to the circle center.
+func circleLines(radius int, line func(x0, x1, dy int)) {
 	// Algorithm designed by https://stackoverflow.com/users/3797048/colinday
 	//
 	// Details: https://stackoverflow.com/questions/10878209/midpoint-circle-algorithm-for-filled-circles#answer-24527943
@@ -170,11 +209,9 @@ func CircFill(centerX, centerY, radius int) {
 	for x >= y {
 		// use symmetry to draw the two horizontal lines at this Y with a special case to draw
 		// only one line at the centerY where y == 0
-		startX := -x + centerX
-		endX := x + centerX
-		horizontalLine(startX, endX, y+centerY)
+		line(-x, x, y)
 		if y != 0 {
-			horizontalLine(startX, endX, -y+centerY)
+			line(-x, x, -y)
 		}
 
 		// move Y one line
@@ -188,13 +225,515 @@ func CircFill(centerX, centerY, radius int) {
 			// symmetry to draw those complete columns as horizontal lines at the top and bottom of the circle
 			// beyond the diagonal of the main loop
 			if x >= y {
-				startX = -y + 1 + centerX
-				endX = y - 1 + centerX
-				horizontalLine(startX, endX, x+centerY)
-				horizontalLine(startX, endX, -x+centerY)
+				line(-y+1, y-1, x)
+				line(-y+1, y-1, -x)
 			}
 			x--
 			radiusError += 2 * (y - x + 1)
 		}
 	}
 }
+
+// Tri draws the outline of a triangle with vertices at (x0, y0), (x1, y1) and (x2, y2).
+//
+// It takes into account the camera position, clipping region,
+// color tables, masks, and fill pattern.
+func Tri(x0, y0, x1, y1, x2, y2 int) {
+	Line(x0, y0, x1, y1)
+	Line(x1, y1, x2, y2)
+	Line(x2, y2, x0, y0)
+}
+
+// TriFill draws a filled triangle with vertices at (x0, y0), (x1, y1) and (x2, y2).
+//
+// The filled triangle covers exactly the same pixels as its outline drawn with Tri,
+// plus all pixels inside.
+//
+// It takes into account the camera position, clipping region,
+// color tables, masks, and fill pattern.
+func TriFill(x0, y0, x1, y1, x2, y2 int) {
+	PolyFill(Position{x0, y0}, Position{x1, y1}, Position{x2, y2})
+}
+
+// Poly draws the outline of a polygon. The last point is connected
+// with the first one.
+//
+// It takes into account the camera position, clipping region,
+// color tables, masks, and fill pattern.
+func Poly(points ...Position) {
+	if len(points) == 0 {
+		return
+	}
+
+	prev := points[len(points)-1]
+	for _, p := range points {
+		Line(prev.X, prev.Y, p.X, p.Y)
+		prev = p
+	}
+}
+
+// PolyFill draws a filled polygon. The polygon can be convex or concave,
+// and its edges may cross each other. Pixels inside are determined
+// using the even-odd rule.
+//
+// The filled polygon covers exactly the same pixels as its outline drawn with Poly,
+// plus all pixels inside. Each pixel is drawn only once.
+//
+// It takes into account the camera position, clipping region,
+// color tables, masks, and fill pattern.
+func PolyFill(points ...Position) {
+	if len(points) == 0 {
+		return
+	}
+
+	minY, maxY := points[0].Y, points[0].Y
+	for _, p := range points[1:] {
+		minY = min(minY, p.Y)
+		maxY = max(maxY, p.Y)
+	}
+	// limit rows to the visible ones:
+	minY = max(minY, clip.Y+Camera.Y)
+	maxY = min(maxY, clip.Y+clip.H-1+Camera.Y)
+	if minY > maxY {
+		return
+	}
+
+	polyFiller.reset(minY, maxY)
+
+	prev := points[len(points)-1]
+	for _, p := range points {
+		polyFiller.addEdge(prev.X, prev.Y, p.X, p.Y)
+		prev = p
+	}
+	polyFiller.addInterior(points)
+	polyFiller.fill(drawColor & ReadMask)
+}
+
+var polyFiller polygonFiller
+
+// polygonFiller collects horizontal spans of pixels for each row
+// and then draws them without drawing any pixel twice.
+//
+// It is reused between calls to avoid allocations.
+type polygonFiller struct {
+	minY, maxY int
+	rows       [][]span
+	crossings  []float64
+}
+
+// span is a horizontal run of pixels from x0 to x1, inclusive.
+type span struct{ x0, x1 int }
+
+func (p *polygonFiller) reset(minY, maxY int) {
+	p.minY, p.maxY = minY, maxY
+	height := maxY - minY + 1
+	if len(p.rows) < height {
+		p.rows = append(p.rows, make([][]span, height-len(p.rows))...)
+	}
+	for i := 0; i < height; i++ {
+		p.rows[i] = p.rows[i][:0]
+	}
+}
+
+func (p *polygonFiller) addSpan(x0, x1, y int) {
+	if y < p.minY || y > p.maxY {
+		return
+	}
+	row := y - p.minY
+	p.rows[row] = append(p.rows[row], span{x0, x1})
+}
+
+// addEdge adds pixels of the edge. Pixels must be the same as the ones drawn by Line.
+func (p *polygonFiller) addEdge(x0, y0, x1, y1 int) {
+	run := float64(x1 - x0)
+	rise := float64(y1 - y0)
+	slope := rise / run
+
+	adjust := 1
+	if slope < 0 {
+		adjust = -1
+	}
+
+	offset := 0.0
+	threshold := 0.5
+
+	if slope >= -1 && slope <= 1 {
+		delta := math.Abs(slope)
+		y := y0
+		if x1 < x0 {
+			x0, x1 = x1, x0
+			y = y1
+		}
+
+		spanStart := x0
+		for x := x0; x <= x1; x++ {
+			offset += delta
+			if offset >= threshold {
+				p.addSpan(spanStart, x, y)
+				spanStart = x + 1
+				y += adjust
+				threshold += 1
+			}
+		}
+		if spanStart <= x1 {
+			p.addSpan(spanStart, x1, y)
+		}
+	} else {
+		delta := math.Abs(run / rise)
+		x := x0
+		if y0 > y1 {
+			y0, y1 = y1, y0
+			x = x1
+		}
+
+		for y := y0; y <= y1; y++ {
+			p.addSpan(x, x, y)
+
+			offset += delta
+			if offset >= threshold {
+				x += adjust
+				threshold += 1
+			}
+		}
+	}
+}
+
+// addInterior adds spans of pixels whose centers are inside the polygon (even-odd rule).
+func (p *polygonFiller) addInterior(points []Position) {
+	for y := p.minY; y <= p.maxY; y++ {
+		p.crossings = p.crossings[:0]
+
+		prev := points[len(points)-1]
+		for _, cur := range points {
+			if (prev.Y <= y && y < cur.Y) || (cur.Y <= y && y < prev.Y) {
+				x := float64(prev.X) + float64(y-prev.Y)*float64(cur.X-prev.X)/float64(cur.Y-prev.Y)
+				p.crossings = append(p.crossings, x)
+			}
+			prev = cur
+		}
+
+		slices.Sort(p.crossings)
+
+		for i := 0; i+1 < len(p.crossings); i += 2 {
+			x0 := int(math.Ceil(p.crossings[i]))
+			x1 := int(math.Floor(p.crossings[i+1]))
+			if x0 <= x1 {
+				p.addSpan(x0, x1, y)
+			}
+		}
+	}
+}
+
+func (p *polygonFiller) fill(draw Color) {
+	for i := 0; i <= p.maxY-p.minY; i++ {
+		row := p.rows[i]
+		if len(row) == 0 {
+			continue
+		}
+		y := p.minY + i
+
+		slices.SortFunc(row, func(a, b span) int {
+			return a.x0 - b.x0
+		})
+
+		current := row[0]
+		for _, s := range row[1:] {
+			if s.x0 <= current.x1+1 {
+				current.x1 = max(current.x1, s.x1)
+				continue
+			}
+			fillSpan(current.x0, current.x1, y, draw)
+			current = s
+		}
+		fillSpan(current.x0, current.x1, y, draw)
+	}
+}
+
+// fillSpan draws pixels from x0 to x1, inclusive.
+func fillSpan(x0, x1, y int, draw Color) {
+	// skip pixels outside the clipping region:
+	x0 = max(x0, clip.X+Camera.X)
+	x1 = min(x1, clip.X+clip.W-1+Camera.X)
+
+	for x := x0; x <= x1; x++ {
+		setPixelWithColor(x, y, draw)
+	}
+}
+
+// Oval draws the outline of an ellipse inscribed in the rectangle
+// between (x0, y0) and (x1, y1), inclusive.
+//
+// It takes into account the camera position, clipping region,
+// color tables, masks, and fill pattern.
+func Oval(x0, y0, x1, y1 int) {
+	top, bottom, first := ovalRows(x0, y0, x1, y1)
+	strokeRows(top, bottom, first, shapeRows, drawColor&ReadMask)
+}
+
+// OvalFill draws a filled ellipse inscribed in the rectangle
+// between (x0, y0) and (x1, y1), inclusive.
+//
+// It takes into account the camera position, clipping region,
+// color tables, masks, and fill pattern.
+func OvalFill(x0, y0, x1, y1 int) {
+	_, _, first := ovalRows(x0, y0, x1, y1)
+	fillRows(first, shapeRows, drawColor&ReadMask)
+}
+
+// ovalRows fills shapeRows with spans of the ellipse. Only visible rows are added (see visibleRows).
+// Returns the top and bottom rows of the ellipse and the row of the first span.
+//
+// Pixel (x, y) is inside the ellipse when its center satisfies the ellipse equation.
+// Coordinates are doubled, so all calculations can be done on integers.
+func ovalRows(x0, y0, x1, y1 int) (top, bottom, first int) {
+	if x0 > x1 {
+		x0, x1 = x1, x0
+	}
+	if y0 > y1 {
+		y0, y1 = y1, y0
+	}
+
+	w := int64(x1 - x0 + 1)
+	h := int64(y1 - y0 + 1)
+	parity := (w - 1) % 2 // parity of doubled x distance from the center
+
+	first, last := visibleRows(y0, y1)
+	shapeRows = shapeRows[:0]
+	for y := first; y <= last; y++ {
+		dy := int64(2*y - y0 - y1)
+		dx := ovalDistance(w, h, dy)
+		if dx%2 != parity {
+			dx--
+		}
+		if dx < 0 {
+			dx = 1 // always draw at least 2 pixels in a row when the width is even
+		}
+		shapeRows = append(shapeRows, span{
+			x0: (x0 + x1 - int(dx)) / 2,
+			x1: (x0 + x1 + int(dx)) / 2,
+		})
+	}
+
+	return y0, y1, first
+}
+
+// ovalDistance returns the widest doubled x distance from the center satisfying:
+// dx²h² + dy²w² <= w²h², which is the same as (dx*h)² <= w²(h²-dy²).
+//
+// Both sides are compared as 128-bit numbers, because they overflow int64 for big ellipses.
+func ovalDistance(w, h, dy int64) int64 {
+	k := uint64(h*h - dy*dy)
+	fits := func(dx int64) bool {
+		leftHi, leftLo := bits.Mul64(uint64(dx*h), uint64(dx*h))
+		rightHi, rightLo := bits.Mul64(uint64(w*w), k)
+		return leftHi < rightHi || leftHi == rightHi && leftLo <= rightLo
+	}
+
+	dx := int64(float64(w) * math.Sqrt(float64(k)) / float64(h))
+	for dx > 0 && !fits(dx) {
+		dx--
+	}
+	for fits(dx + 1) {
+		dx++
+	}
+	return dx
+}
+
+// visibleRows limits rows y0..y1 to the ones inside the clipping region,
+// plus one row above and below, which strokeRows needs to find neighbours.
+func visibleRows(y0, y1 int) (first, last int) {
+	return max(y0, clip.Y+Camera.Y-1), min(y1, clip.Y+clip.H+Camera.Y)
+}
+
+// RectRound draws the outline of a rectangle between (x0, y0) and (x1, y1), inclusive,
+// with rounded corners of radius r.
+//
+// The radius is limited, so corners never overlap.
+//
+// It takes into account the camera position, clipping region,
+// color tables, masks, and fill pattern.
+func RectRound(x0, y0, x1, y1, r int) {
+	top, bottom, first := rectRoundRows(x0, y0, x1, y1, r)
+	strokeRows(top, bottom, first, shapeRows, drawColor&ReadMask)
+}
+
+// RectRoundFill draws a filled rectangle between (x0, y0) and (x1, y1), inclusive,
+// with rounded corners of radius r.
+//
+// The radius is limited, so corners never overlap.
+//
+// It takes into account the camera position, clipping region,
+// color tables, masks, and fill pattern.
+func RectRoundFill(x0, y0, x1, y1, r int) {
+	_, _, first := rectRoundRows(x0, y0, x1, y1, r)
+	fillRows(first, shapeRows, drawColor&ReadMask)
+}
+
+// rectRoundRows fills shapeRows with spans of the rounded rectangle. Only visible rows
+// are added (see visibleRows). Returns the top and bottom rows of the rectangle
+// and the row of the first span.
+func rectRoundRows(x0, y0, x1, y1, r int) (top, bottom, first int) {
+	if x0 > x1 {
+		x0, x1 = x1, x0
+	}
+	if y0 > y1 {
+		y0, y1 = y1, y0
+	}
+
+	r = min(r, (x1-x0)/2, (y1-y0)/2)
+	r = max(r, 0)
+
+	// half widths of circle lines, indexed by distance from the circle center:
+	halfWidths = slices.Grow(halfWidths[:0], r+1)[:r+1]
+	circleLines(r, func(_, x1, dy int) {
+		if dy >= 0 {
+			halfWidths[dy] = x1
+		}
+	})
+
+	first, last := visibleRows(y0, y1)
+	shapeRows = shapeRows[:0]
+	for y := first; y <= last; y++ {
+		inset := 0
+		if y < y0+r {
+			inset = r - halfWidths[y0+r-y]
+		} else if y > y1-r {
+			inset = r - halfWidths[y-(y1-r)]
+		}
+		shapeRows = append(shapeRows, span{x0: x0 + inset, x1: x1 - inset})
+	}
+
+	return y0, y1, first
+}
+
+// Arc draws a fragment of a circle outline with center at (cx, cy) and radius r.
+//
+// The arc starts at angle start and goes clockwise to angle end.
+// Angles are in radians. Angle 0 points right and angle math.Pi/2 points down.
+// If the arc is longer than 2*math.Pi, the entire circle is drawn.
+//
+// It takes into account the camera position, clipping region,
+// color tables, masks, and fill pattern.
+func Arc(cx, cy, r int, start, end float64) {
+	draw := drawColor & ReadMask
+	sec := newSector(start, end)
+
+	circlePixels(r, func(dx, dy int) {
+		if sec.contains(dx, dy) {
+			setPixelWithColor(cx+dx, cy+dy, draw)
+		}
+	})
+}
+
+// PieFill draws a filled slice of a circle with center at (cx, cy) and radius r.
+//
+// The slice starts at angle start and goes clockwise to angle end.
+// Angles are in radians. Angle 0 points right and angle math.Pi/2 points down.
+// If the slice is bigger than 2*math.Pi, the entire circle is drawn.
+//
+// It takes into account the camera position, clipping region,
+// color tables, masks, and fill pattern.
+func PieFill(cx, cy, r int, start, end float64) {
+	draw := drawColor & ReadMask
+	sec := newSector(start, end)
+
+	circleLines(r, func(x0, x1, dy int) {
+		for dx := x0; dx <= x1; dx++ {
+			if sec.contains(dx, dy) {
+				setPixelWithColor(cx+dx, cy+dy, draw)
+			}
+		}
+	})
+}
+
+// sector is a part of a plane between two rays starting at (0, 0).
+type sector struct {
+	startX, startY float64
+	endX, endY     float64
+	full           bool // sector covers the entire plane
+	wide           bool // sector is wider than 180 degrees
+}
+
+func newSector(start, end float64) sector {
+	sweep := end - start
+	if sweep >= 2*math.Pi {
+		return sector{full: true}
+	}
+	sweep = math.Mod(sweep, 2*math.Pi)
+	if sweep < 0 {
+		sweep += 2 * math.Pi
+	}
+
+	return sector{
+		startX: math.Cos(start),
+		startY: math.Sin(start),
+		endX:   math.Cos(start + sweep),
+		endY:   math.Sin(start + sweep),
+		wide:   sweep > math.Pi,
+	}
+}
+
+func (s sector) contains(dx, dy int) bool {
+	if s.full {
+		return true
+	}
+
+	// epsilon compensates rounding errors of Sin and Cos, so pixels lying
+	// exactly on the rays are always included
+	const epsilon = 1e-9
+
+	x, y := float64(dx), float64(dy)
+	if s.wide {
+		// outside the sector which is complementary to s
+		return !(s.endX*y-s.endY*x > epsilon && x*s.startY-y*s.startX > epsilon)
+	}
+
+	afterStart := s.startX*y-s.startY*x >= -epsilon
+	beforeEnd := x*s.endY-y*s.endX >= -epsilon
+	// reject the ray opposite to start and end:
+	sameDirection := s.startX*x+s.startY*y >= -epsilon || s.endX*x+s.endY*y >= -epsilon
+
+	return afterStart && beforeEnd && sameDirection
+}
+
+// shapeRows holds a single span for each visible row of a convex shape.
+//
+// It is reused between calls to avoid allocations.
+var shapeRows []span
+
+var halfWidths []int
+
+func fillRows(y0 int, rows []span, draw Color) {
+	for i, row := range rows {
+		fillSpan(row.x0, row.x1, y0+i, draw)
+	}
+}
+
+// strokeRows draws only pixels which have at least one neighbour
+// (left, right, top or bottom) outside the shape.
+//
+// top and bottom are the rows of the whole shape. rows start at row y0
+// and may contain only a part of the shape.
+func strokeRows(top, bottom, y0 int, rows []span, draw Color) {
+	for i, row := range rows {
+		y := y0 + i
+		if y == top || y == bottom {
+			fillSpan(row.x0, row.x1, y, draw)
+			continue
+		}
+		if i == 0 || i == len(rows)-1 {
+			continue // row outside the clipping region, used only as a neighbour
+		}
+
+		prev, next := rows[i-1], rows[i+1]
+		interiorX0 := max(row.x0+1, prev.x0, next.x0)
+		interiorX1 := min(row.x1-1, prev.x1, next.x1)
+		if interiorX0 > interiorX1 {
+			fillSpan(row.x0, row.x1, y, draw)
+			continue
+		}
+
+		fillSpan(row.x0, interiorX0-1, y, draw)
+		fillSpan(interiorX1+1, row.x1, y, draw)
+	}
+}
@@ -5,6 +5,7 @@ package pi_test
 
 import (
 	_ "embed"
+	"math"
 	"testing"
 
 	"github.com/elgopher/pi"
@@ -215,3 +216,344 @@ func circfill(cx, cy, r int) func() {
 		pi.CircFill(cx, cy, r)
 	}
 }
+
+func TestTriFill(t *testing.T) {
+	pi.ResetColorTables()
+
+	t.Run("should fill triangle", func(t *testing.T) {
+		pi.SetDrawTarget(pi.NewCanvas(5, 5))
+		pi.SetColor(1)
+		// when
+		pi.TriFill(0, 0, 4, 0, 0, 4)
+		// then
+		expected := pi.NewCanvas(5, 5)
+		expected.SetAll(
+			1, 1, 1, 1, 1,
+			1, 1, 1, 1, 0,
+			1, 1, 1, 0, 0,
+			1, 1, 0, 0, 0,
+			1, 0, 0, 0, 0,
+		)
+		pitest.AssertSurfaceEqual(t, expected, pi.DrawTarget())
+	})
+
+	t.Run("should cover all pixels of the outline", func(t *testing.T) {
+		triangles := [][6]int{
+			{1, 1, 30, 7, 12, 28},
+			{30, 2, 2, 3, 15, 30},
+			{5, 5, 6, 25, 7, 12},
+			{0, 31, 31, 0, 31, 31},
+			{3, 3, 3, 3, 3, 3},
+		}
+		for _, tri := range triangles {
+			outline := pi.NewCanvas(32, 32)
+			pi.SetDrawTarget(outline)
+			pi.SetColor(1)
+			pi.Tri(tri[0], tri[1], tri[2], tri[3], tri[4], tri[5])
+
+			filled := pi.NewCanvas(32, 32)
+			pi.SetDrawTarget(filled)
+			pi.TriFill(tri[0], tri[1], tri[2], tri[3], tri[4], tri[5])
+
+			for i, c := range outline.Data() {
+				if c == 1 {
+					require.Equal(t, pi.Color(1), filled.Data()[i], "triangle %v, pixel %d", tri, i)
+				}
+			}
+		}
+	})
+}
+
+func TestPolyFill(t *testing.T) {
+	t.Run("should fill concave polygon", func(t *testing.T) {
+		pi.ResetColorTables()
+		pi.SetDrawTarget(pi.NewCanvas(5, 5))
+		pi.SetColor(1)
+		// when
+		pi.PolyFill(
+			pi.Position{X: 0, Y: 0},
+			pi.Position{X: 2, Y: 2},
+			pi.Position{X: 4, Y: 0},
+			pi.Position{X: 4, Y: 4},
+			pi.Position{X: 0, Y: 4},
+		)
+		// then
+		expected := pi.NewCanvas(5, 5)
+		expected.SetAll(
+			1, 0, 0, 0, 1,
+			1, 1, 0, 1, 1,
+			1, 1, 1, 1, 1,
+			1, 1, 1, 1, 1,
+			1, 1, 1, 1, 1,
+		)
+		pitest.AssertSurfaceEqual(t, expected, pi.DrawTarget())
+	})
+
+	t.Run("should draw each pixel only once", func(t *testing.T) {
+		pi.ResetColorTables()
+		defer pi.ResetColorTables()
+		// drawing color 1 increments the target color
+		for target := pi.Color(0); target < pi.MaxColors-1; target++ {
+			pi.ColorTables[0][1][target] = target + 1
+		}
+		pi.SetDrawTarget(pi.NewCanvas(16, 16))
+		pi.SetColor(1)
+		// when
+		pi.PolyFill(
+			pi.Position{X: 1, Y: 1},
+			pi.Position{X: 14, Y: 3},
+			pi.Position{X: 2, Y: 8},
+			pi.Position{X: 13, Y: 14},
+		)
+		// then
+		for _, c := range pi.DrawTarget().Data() {
+			require.LessOrEqual(t, c, pi.Color(1))
+		}
+	})
+
+	t.Run("should take camera and clip into account", func(t *testing.T) {
+		pi.ResetColorTables()
+		pi.SetDrawTarget(pi.NewCanvas(4, 4))
+		pi.SetClip(pi.IntArea{X: 1, Y: 1, W: 2, H: 2})
+		pi.Camera = pi.Position{X: -1, Y: -1}
+		defer func() {
+			pi.Camera = pi.Position{}
+		}()
+		pi.SetColor(1)
+		// when
+		pi.PolyFill(
+			pi.Position{X: -10, Y: -10},
+			pi.Position{X: 10, Y: -10},
+			pi.Position{X: 10, Y: 10},
+			pi.Position{X: -10, Y: 10},
+		)
+		// then
+		expected := pi.NewCanvas(4, 4)
+		expected.SetAll(
+			0, 0, 0, 0,
+			0, 1, 1, 0,
+			0, 1, 1, 0,
+			0, 0, 0, 0,
+		)
+		pitest.AssertSurfaceEqual(t, expected, pi.DrawTarget())
+	})
+
+	t.Run("should not panic for no points", func(t *testing.T) {
+		pi.PolyFill()
+		pi.Poly()
+	})
+}
+
+func TestOval(t *testing.T) {
+	pi.ResetColorTables()
+
+	t.Run("should draw oval", func(t *testing.T) {
+		pi.SetDrawTarget(pi.NewCanvas(8, 5))
+		pi.SetColor(1)
+		// when
+		pi.Oval(0, 0, 7, 4)
+		// then
+		expected := pi.NewCanvas(8, 5)
+		expected.SetAll(
+			0, 0, 1, 1, 1, 1, 0, 0,
+			1, 1, 0, 0, 0, 0, 1, 1,
+			1, 0, 0, 0, 0, 0, 0, 1,
+			1, 1, 0, 0, 0, 0, 1, 1,
+			0, 0, 1, 1, 1, 1, 0, 0,
+		)
+		pitest.AssertSurfaceEqual(t, expected, pi.DrawTarget())
+	})
+
+	t.Run("should draw filled oval", func(t *testing.T) {
+		pi.SetDrawTarget(pi.NewCanvas(8, 5))
+		pi.SetColor(1)
+		// when
+		pi.OvalFill(7, 4, 0, 0)
+		// then
+		expected := pi.NewCanvas(8, 5)
+		expected.SetAll(
+			0, 0, 1, 1, 1, 1, 0, 0,
+			1, 1, 1, 1, 1, 1, 1, 1,
+			1, 1, 1, 1, 1, 1, 1, 1,
+			1, 1, 1, 1, 1, 1, 1, 1,
+			0, 0, 1, 1, 1, 1, 0, 0,
+		)
+		pitest.AssertSurfaceEqual(t, expected, pi.DrawTarget())
+	})
+
+	t.Run("should draw 1 pixel wide oval", func(t *testing.T) {
+		pi.SetDrawTarget(pi.NewCanvas(3, 3))
+		pi.SetColor(1)
+		// when
+		pi.OvalFill(1, 0, 1, 2)
+		// then
+		expected := pi.NewCanvas(3, 3)
+		expected.SetAll(
+			0, 1, 0,
+			0, 1, 0,
+			0, 1, 0,
+		)
+		pitest.AssertSurfaceEqual(t, expected, pi.DrawTarget())
+	})
+
+	t.Run("clipped oval should draw the same pixels as not clipped one", func(t *testing.T) {
+		expected := pi.NewCanvas(8, 5)
+		pi.SetDrawTarget(expected)
+		pi.SetColor(1)
+		pi.Oval(0, 0, 7, 4)
+		pi.RectFill(0, 0, 7, 0)
+		pi.RectFill(0, 4, 7, 4)
+
+		actual := pi.NewCanvas(8, 5)
+		pi.SetDrawTarget(actual)
+		pi.SetColor(1)
+		pi.RectFill(0, 0, 7, 0)
+		pi.RectFill(0, 4, 7, 4)
+		pi.SetClip(pi.IntArea{X: 0, Y: 1, W: 8, H: 3})
+		// when
+		pi.Oval(0, 0, 7, 4)
+		// then
+		pitest.AssertSurfaceEqual(t, expected, actual)
+	})
+
+	t.Run("should draw big oval", func(t *testing.T) {
+		pi.SetDrawTarget(pi.NewCanvas(3, 3))
+		pi.SetColor(1)
+		// when
+		pi.Oval(0, -100000, 200000, 100002)
+		// then
+		expected := pi.NewCanvas(3, 3)
+		expected.SetAll(
+			1, 0, 0,
+			1, 0, 0,
+			1, 0, 0,
+		)
+		pitest.AssertSurfaceEqual(t, expected, pi.DrawTarget())
+	})
+}
+
+func TestRectRound(t *testing.T) {
+	pi.ResetColorTables()
+
+	t.Run("should draw rounded rectangle", func(t *testing.T) {
+		pi.SetDrawTarget(pi.NewCanvas(7, 6))
+		pi.SetColor(1)
+		// when
+		pi.RectRound(0, 0, 6, 5, 2)
+		// then
+		expected := pi.NewCanvas(7, 6)
+		expected.SetAll(
+			0, 1, 1, 1, 1, 1, 0,
+			1, 0, 0, 0, 0, 0, 1,
+			1, 0, 0, 0, 0, 0, 1,
+			1, 0, 0, 0, 0, 0, 1,
+			1, 0, 0, 0, 0, 0, 1,
+			0, 1, 1, 1, 1, 1, 0,
+		)
+		pitest.AssertSurfaceEqual(t, expected, pi.DrawTarget())
+	})
+
+	t.Run("zero radius should draw the same rectangle as Rect and RectFill", func(t *testing.T) {
+		expected := pi.NewCanvas(8, 8)
+		pi.SetDrawTarget(expected)
+		pi.SetColor(1)
+		pi.RectFill(1, 1, 6, 5)
+		pi.SetColor(2)
+		pi.Rect(1, 1, 6, 5)
+
+		actual := pi.NewCanvas(8, 8)
+		pi.SetDrawTarget(actual)
+		pi.SetColor(1)
+		pi.RectRoundFill(1, 1, 6, 5, 0)
+		pi.SetColor(2)
+		pi.RectRound(1, 1, 6, 5, 0)
+
+		pitest.AssertSurfaceEqual(t, expected, actual)
+	})
+
+	t.Run("clipped rounded rectangle should draw the same pixels as not clipped one", func(t *testing.T) {
+		expected := pi.NewCanvas(7, 6)
+		pi.SetDrawTarget(expected)
+		pi.SetColor(1)
+		pi.RectRound(0, 0, 6, 5, 2)
+		pi.RectFill(0, 0, 6, 1)
+
+		actual := pi.NewCanvas(7, 6)
+		pi.SetDrawTarget(actual)
+		pi.SetColor(1)
+		pi.RectFill(0, 0, 6, 1)
+		pi.SetClip(pi.IntArea{X: 0, Y: 2, W: 7, H: 4})
+		// when
+		pi.RectRound(0, 0, 6, 5, 2)
+		// then
+		pitest.AssertSurfaceEqual(t, expected, actual)
+	})
+}
+
+func TestArc(t *testing.T) {
+	pi.ResetColorTables()
+
+	t.Run("full arc should draw the same circle as Circ", func(t *testing.T) {
+		expected := pi.NewCanvas(16, 16)
+		pi.SetDrawTarget(expected)
+		pi.SetColor(1)
+		pi.Circ(8, 8, 6)
+
+		actual := pi.NewCanvas(16, 16)
+		pi.SetDrawTarget(actual)
+		pi.Arc(8, 8, 6, 1, 1+2*math.Pi)
+
+		pitest.AssertSurfaceEqual(t, expected, actual)
+	})
+
+	t.Run("should draw quarter of the circle", func(t *testing.T) {
+		pi.SetDrawTarget(pi.NewCanvas(5, 5))
+		pi.SetColor(1)
+		// when
+		pi.Arc(0, 0, 4, 0, math.Pi/2)
+		// then
+		expected := pi.NewCanvas(5, 5)
+		expected.SetAll(
+			0, 0, 0, 0, 1,
+			0, 0, 0, 0, 1,
+			0, 0, 0, 1, 0,
+			0, 0, 1, 1, 0,
+			1, 1, 0, 0, 0,
+		)
+		pitest.AssertSurfaceEqual(t, expected, pi.DrawTarget())
+	})
+}
+
+func TestPieFill(t *testing.T) {
+	pi.ResetColorTables()
+
+	t.Run("full pie should draw the same circle as CircFill", func(t *testing.T) {
+		expected := pi.NewCanvas(16, 16)
+		pi.SetDrawTarget(expected)
+		pi.SetColor(1)
+		pi.CircFill(8, 8, 6)
+
+		actual := pi.NewCanvas(16, 16)
+		pi.SetDrawTarget(actual)
+		pi.PieFill(8, 8, 6, 0, 2*math.Pi)
+
+		pitest.AssertSurfaceEqual(t, expected, actual)
+	})
+
+	t.Run("should fill half of the circle", func(t *testing.T) {
+		pi.SetDrawTarget(pi.NewCanvas(5, 5))
+		pi.SetColor(1)
+		// when
+		pi.PieFill(2, 2, 2, math.Pi, 2*math.Pi) // upper half
+		// then
+		expected := pi.NewCanvas(5, 5)
+		expected.SetAll(
+			0, 1, 1, 1, 0,
+			1, 1, 1, 1, 1,
+			1, 1, 1, 1, 1,
+			0, 0, 0, 0, 0,
+			0, 0, 0, 0, 0,
+		)
+		pitest.AssertSurfaceEqual(t, expected, pi.DrawTarget())
+	})
+}
@@ -4,9 +4,11 @@
 package pi_test
 
 import (
+	"math"
 	"testing"
 
 	"github.com/elgopher/pi"
+	"github.com/elgopher/pi/pitest"
 )
 
 func TestStretch(t *testing.T) {
@@ -30,3 +32,109 @@ func TestStretch(t *testing.T) {
 
 	pi.Stretch(spr.WithSize(0, 0), 0, 0, 8, 8)
 }
+
+func TestDrawRotated(t *testing.T) {
+	pi.ResetColorTables()
+	pi.Camera = pi.Position{}
+
+	src := pi.NewCanvas(3, 2)
+	src.SetAll(
+		1, 2, 3,
+		4, 5, 6,
+	)
+	spr := pi.CanvasSprite(src)
+
+	t.Run("should draw the same as DrawSprite when not rotated and not scaled", func(t *testing.T) {
+		expected := pi.NewCanvas(5, 5)
+		pi.SetDrawTarget(expected)
+		pi.DrawSprite(spr, 1, 2)
+
+		actual := pi.NewCanvas(5, 5)
+		pi.SetDrawTarget(actual)
+		// when
+		pi.DrawRotated(spr, 2, 3, 1, 1, 0, 1)
+		// then
+		pitest.AssertSurfaceEqual(t, expected, actual)
+	})
+
+	t.Run("should rotate clockwise around pivot", func(t *testing.T) {
+		pi.SetDrawTarget(pi.NewCanvas(4, 4))
+		// when
+		pi.DrawRotated(spr, 2, 2, 0, 0, math.Pi/2, 1)
+		// then
+		expected := pi.NewCanvas(4, 4)
+		expected.SetAll(
+			0, 0, 0, 0,
+			0, 0, 0, 0,
+			4, 1, 0, 0,
+			5, 2, 0, 0,
+		)
+		pitest.AssertSurfaceEqual(t, expected, pi.DrawTarget())
+	})
+
+	t.Run("should rotate flipped sprite", func(t *testing.T) {
+		pi.SetDrawTarget(pi.NewCanvas(3, 3))
+		// when
+		pi.DrawRotated(spr.WithFlipX(true), 1, 1, 1, 1, math.Pi, 1)
+		// then
+		expected := pi.NewCanvas(3, 3)
+		expected.SetAll(
+			5, 6, 0,
+			2, 3, 0,
+			0, 0, 0,
+		)
+		pitest.AssertSurfaceEqual(t, expected, pi.DrawTarget())
+	})
+
+	t.Run("should scale", func(t *testing.T) {
+		pi.SetDrawTarget(pi.NewCanvas(6, 4))
+		// when
+		pi.DrawRotated(spr, 0, 0, 0, 0, 0, 2)
+		// then
+		expected := pi.NewCanvas(6, 4)
+		expected.SetAll(
+			1, 1, 2, 2, 3, 3,
+			1, 1, 2, 2, 3, 3,
+			4, 4, 5, 5, 6, 6,
+			4, 4, 5, 5, 6, 6,
+		)
+		pitest.AssertSurfaceEqual(t, expected, pi.DrawTarget())
+	})
+
+	t.Run("should take camera, clip and color tables into account", func(t *testing.T) {
+		pi.SetDrawTarget(pi.NewCanvas(4, 4))
+		pi.SetClip(pi.IntArea{X: 0, Y: 0, W: 2, H: 4})
+		pi.Camera = pi.Position{X: 1, Y: -1}
+		defer func() {
+			pi.Camera = pi.Position{}
+		}()
+		pi.SetTransparency(2, true)
+		defer pi.ResetColorTables()
+		// when
+		pi.DrawRotated(spr, 1, 0, 0, 0, 0, 1)
+		// then
+		expected := pi.NewCanvas(4, 4)
+		expected.SetAll(
+			0, 0, 0, 0,
+			1, 0, 0, 0,
+			4, 5, 0, 0,
+			0, 0, 0, 0,
+		)
+		pitest.AssertSurfaceEqual(t, expected, pi.DrawTarget())
+	})
+
+	t.Run("should not draw pixels outside the source canvas", func(t *testing.T) {
+		pi.SetDrawTarget(pi.NewCanvas(4, 4))
+		// when
+		pi.DrawRotated(spr.WithSize(4, 3), 0, 0, 0, 0, 0, 1)
+		// then
+		expected := pi.NewCanvas(4, 4)
+		expected.SetAll(
+			1, 2, 3, 0,
+			4, 5, 6, 0,
+			0, 0, 0, 0,
+			0, 0, 0, 0,
+		)
+		pitest.AssertSurfaceEqual(t, expected, pi.DrawTarget())
+	})
+}
@@ -329,6 +329,51 @@ func DecodeCanvasOrErr(pngFile []byte) (Canvas, error) {
 	return canvas, nil
 }
 
+// EncodeCanvas encodes the Canvas into a PNG file with indexed color mode,
+// using the current Palette. When applyMapping is false, such file can be
+// decoded back with DecodeCanvas without any loss.
+//
+// When applyMapping is true, PNG palette colors are remapped using PaletteMapping,
+// so the image looks the same as the canvas drawn on the screen. Such file
+// cannot be decoded back without loss, because DecodeCanvas returns
+// the mapped colors, not the original ones.
+//
+// Only the first 6 bits of each color are stored.
+func EncodeCanvas(canvas Canvas, applyMapping bool) []byte {
+	pngFile, err := EncodeCanvasOrErr(canvas, applyMapping)
+	if err != nil {
+		panic(err)
+	}
+	return pngFile
+}
+
+// EncodeCanvasOrErr is like EncodeCanvas but returns an error.
+//
+// It returns an error if the canvas is empty.
+func EncodeCanvasOrErr(canvas Canvas, applyMapping bool) ([]byte, error) {
+	var palette color.Palette
+	for i := range Palette {
+		col := Color(i)
+		if applyMapping {
+			col = PaletteMapping[i] & (MaxColors - 1)
+		}
+		r, g, b := Palette[col].RGB()
+		palette = append(palette, color.NRGBA{R: r, G: g, B: b, A: 255})
+	}
+
+	img := image.NewPaletted(image.Rect(0, 0, canvas.width, canvas.height), palette)
+	for i, c := range canvas.data {
+		img.Pix[i] = c & (MaxColors - 1)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("PNG encoding failed: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
 func samePalette(palette color.Palette) bool {
 	for i := 0; i < len(palette); i++ {
 		r, g, b, _ := palette[i].RGBA()
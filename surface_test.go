@@ -53,6 +53,48 @@ func TestDecodeCanvasOrErr(t *testing.T) {
 	}
 }
 
+func TestEncodeCanvasOrErr(t *testing.T) {
+	t.Run("should encode canvas which can be decoded back", func(t *testing.T) {
+		pi.Palette = pi.DecodePalette(indexedPNG)
+		canvas := pi.NewCanvas(3, 2)
+		canvas.SetAll(
+			0, 1, 2,
+			13, 14, 15,
+		)
+		// when
+		pngFile, err := pi.EncodeCanvasOrErr(canvas, false)
+		// then
+		require.NoError(t, err)
+		decoded, err := pi.DecodeCanvasOrErr(pngFile)
+		require.NoError(t, err)
+		pitest.AssertSurfaceEqual(t, canvas, decoded)
+	})
+
+	t.Run("should apply palette mapping, so decoded canvas has mapped colors", func(t *testing.T) {
+		pi.Palette = pi.DecodePalette(indexedPNG)
+		pi.PaletteMapping[1] = 2
+		defer pi.ResetPaletteMapping()
+		canvas := pi.NewCanvas(2, 1)
+		canvas.SetAll(1, 2)
+		// when
+		pngFile, err := pi.EncodeCanvasOrErr(canvas, true)
+		// then
+		require.NoError(t, err)
+		pi.ResetPaletteMapping()
+		decoded, err := pi.DecodeCanvasOrErr(pngFile)
+		require.NoError(t, err)
+		expected := pi.NewCanvas(2, 1)
+		expected.SetAll(2, 2)
+		pitest.AssertSurfaceEqual(t, expected, decoded)
+		assert.NotEqual(t, canvas.Data(), decoded.Data(), "round trip with mapping is lossy")
+	})
+
+	t.Run("should return error for empty canvas", func(t *testing.T) {
+		_, err := pi.EncodeCanvasOrErr(pi.NewCanvas(0, 0), false)
+		assert.Error(t, err)
+	})
+}
+
 func TestSurface_Set(t *testing.T) {
 	t.Run("should be noop when outside surface", func(t *testing.T) {
 		width := 2
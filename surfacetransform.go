@@ -0,0 +1,131 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pi
+
+import (
+	"fmt"
+	"slices"
+)
+
+// FlipX flips the surface horizontally in place.
+//
+// To create a flipped copy, flip a clone: c := s.Clone(); c.FlipX().
+func (m Surface[T]) FlipX() {
+	for _, line := range m.LinesIterator(m.EntireArea()) {
+		slices.Reverse(line)
+	}
+}
+
+// FlipY flips the surface vertically in place.
+//
+// To create a flipped copy, flip a clone: c := s.Clone(); c.FlipY().
+func (m Surface[T]) FlipY() {
+	for top, bottom := 0, m.height-1; top < bottom; top, bottom = top+1, bottom-1 {
+		topLine := m.data[top*m.width : (top+1)*m.width]
+		bottomLine := m.data[bottom*m.width : (bottom+1)*m.width]
+		for i := range topLine {
+			topLine[i], bottomLine[i] = bottomLine[i], topLine[i]
+		}
+	}
+}
+
+// Rotate180 rotates the surface by 180 degrees in place.
+//
+// To create a rotated copy, rotate a clone: c := s.Clone(); c.Rotate180().
+func (m Surface[T]) Rotate180() {
+	slices.Reverse(m.data)
+}
+
+// Rotated90 returns a new surface rotated by 90 degrees clockwise.
+//
+// Width and height of the new surface are swapped.
+func (m Surface[T]) Rotated90() Surface[T] {
+	rotated := NewSurface[T](m.height, m.width)
+	for y := 0; y < rotated.height; y++ {
+		line := rotated.data[y*rotated.width : (y+1)*rotated.width]
+		for x := range line {
+			line[x] = m.data[(m.height-1-x)*m.width+y]
+		}
+	}
+	return rotated
+}
+
+// Rotated270 returns a new surface rotated by 270 degrees clockwise
+// (90 degrees counterclockwise).
+//
+// Width and height of the new surface are swapped.
+func (m Surface[T]) Rotated270() Surface[T] {
+	rotated := NewSurface[T](m.height, m.width)
+	for y := 0; y < rotated.height; y++ {
+		line := rotated.data[y*rotated.width : (y+1)*rotated.width]
+		for x := range line {
+			line[x] = m.data[x*m.width+m.width-1-y]
+		}
+	}
+	return rotated
+}
+
+// Scaled returns a new surface enlarged sx times horizontally
+// and sy times vertically, using nearest-neighbour.
+//
+// It panics if sx or sy is lower than 1.
+func (m Surface[T]) Scaled(sx, sy int) Surface[T] {
+	if sx < 1 || sy < 1 {
+		panic(fmt.Sprintf("invalid scale %dx%d. Must be at least 1x1", sx, sy))
+	}
+
+	scaled := NewSurface[T](m.width*sx, m.height*sy)
+	for y := 0; y < m.height; y++ {
+		srcLine := m.data[y*m.width : (y+1)*m.width]
+		first := scaled.data[y*sy*scaled.width : (y*sy+1)*scaled.width]
+		for x, v := range srcLine {
+			for i := 0; i < sx; i++ {
+				first[x*sx+i] = v
+			}
+		}
+		for i := 1; i < sy; i++ {
+			copy(scaled.data[(y*sy+i)*scaled.width:], first)
+		}
+	}
+	return scaled
+}
+
+// Shift moves the content of the surface by (dx, dy) in place.
+// Values moved outside the surface wrap around to the opposite edge.
+//
+// For example, Shift(1, 0) moves every value one cell right and the
+// last column becomes the first one.
+func (m Surface[T]) Shift(dx, dy int) {
+	if m.width == 0 || m.height == 0 {
+		return
+	}
+
+	if dx = wrap(dx, m.width); dx != 0 {
+		for _, line := range m.LinesIterator(m.EntireArea()) {
+			rotateRight(line, dx)
+		}
+	}
+
+	if dy = wrap(dy, m.height); dy != 0 {
+		rotateRight(m.data, dy*m.width)
+	}
+}
+
+// rotateRight rotates s by n elements to the right, without allocating.
+func rotateRight[T any](s []T, n int) {
+	slices.Reverse(s)
+	slices.Reverse(s[:n])
+	slices.Reverse(s[n:])
+}
+
+// Resized returns a new surface with the given size, keeping
+// the content anchored to the top-left corner.
+//
+// Content outside the new size is cut off and new cells
+// are filled with zero values.
+func (m Surface[T]) Resized(w, h int) Surface[T] {
+	resized := NewSurface[T](w, h)
+	resized.SetSurface(0, 0, m)
+	return resized
+}
@@ -0,0 +1,120 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pi_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elgopher/pi"
+)
+
+// newSurface3x2 creates a surface:
+//
+//	1 2 3
+//	4 5 6
+func newSurface3x2() pi.Surface[int] {
+	s := pi.NewSurface[int](3, 2)
+	s.SetAll(1, 2, 3, 4, 5, 6)
+	return s
+}
+
+func TestSurface_FlipX(t *testing.T) {
+	s := newSurface3x2()
+	s.FlipX()
+	assert.Equal(t, []int{3, 2, 1, 6, 5, 4}, s.Data())
+}
+
+func TestSurface_FlipY(t *testing.T) {
+	s := pi.NewSurface[int](2, 3)
+	s.SetAll(1, 2, 3, 4, 5, 6)
+	s.FlipY()
+	assert.Equal(t, []int{5, 6, 3, 4, 1, 2}, s.Data())
+}
+
+func TestSurface_Rotate180(t *testing.T) {
+	s := newSurface3x2()
+	s.Rotate180()
+	assert.Equal(t, []int{6, 5, 4, 3, 2, 1}, s.Data())
+}
+
+func TestSurface_Rotated90(t *testing.T) {
+	rotated := newSurface3x2().Rotated90()
+	assert.Equal(t, 2, rotated.W())
+	assert.Equal(t, 3, rotated.H())
+	assert.Equal(t, []int{
+		4, 1,
+		5, 2,
+		6, 3,
+	}, rotated.Data())
+}
+
+func TestSurface_Rotated270(t *testing.T) {
+	rotated := newSurface3x2().Rotated270()
+	assert.Equal(t, 2, rotated.W())
+	assert.Equal(t, 3, rotated.H())
+	assert.Equal(t, []int{
+		3, 6,
+		2, 5,
+		1, 4,
+	}, rotated.Data())
+}
+
+func TestSurface_Scaled(t *testing.T) {
+	t.Run("should scale", func(t *testing.T) {
+		s := pi.NewSurface[int](2, 1)
+		s.SetAll(1, 2)
+		scaled := s.Scaled(2, 3)
+		assert.Equal(t, 4, scaled.W())
+		assert.Equal(t, 3, scaled.H())
+		assert.Equal(t, []int{
+			1, 1, 2, 2,
+			1, 1, 2, 2,
+			1, 1, 2, 2,
+		}, scaled.Data())
+	})
+
+	t.Run("should panic for invalid scale", func(t *testing.T) {
+		assert.Panics(t, func() {
+			newSurface3x2().Scaled(0, 1)
+		})
+	})
+}
+
+func TestSurface_Shift(t *testing.T) {
+	tests := map[string]struct {
+		dx, dy   int
+		expected []int
+	}{
+		"no shift":     {expected: []int{1, 2, 3, 4, 5, 6}},
+		"right":        {dx: 1, expected: []int{3, 1, 2, 6, 4, 5}},
+		"left":         {dx: -1, expected: []int{2, 3, 1, 5, 6, 4}},
+		"down":         {dy: 1, expected: []int{4, 5, 6, 1, 2, 3}},
+		"up and right": {dx: 4, dy: -3, expected: []int{6, 4, 5, 3, 1, 2}},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := newSurface3x2()
+			s.Shift(test.dx, test.dy)
+			assert.Equal(t, test.expected, s.Data())
+		})
+	}
+}
+
+func TestSurface_Resized(t *testing.T) {
+	t.Run("should enlarge", func(t *testing.T) {
+		resized := newSurface3x2().Resized(4, 3)
+		assert.Equal(t, []int{
+			1, 2, 3, 0,
+			4, 5, 6, 0,
+			0, 0, 0, 0,
+		}, resized.Data())
+	})
+
+	t.Run("should shrink", func(t *testing.T) {
+		resized := newSurface3x2().Resized(2, 1)
+		assert.Equal(t, []int{1, 2}, resized.Data())
+	})
+}
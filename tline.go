@@ -0,0 +1,160 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pi
+
+import "math"
+
+// TLine draws a textured line between (x0, y0) and (x1, y1), inclusive.
+//
+// Pixels are sampled from the sprite, starting at texture coordinates (u, v)
+// relative to the sprite's top-left corner. For each pixel drawn, the texture
+// coordinates are advanced by (du, dv). Texture coordinates wrap around the
+// sprite size, so the sprite is repeated infinitely.
+//
+// Pixels are sampled using nearest-neighbour.
+//
+// It takes into account the camera position, clipping region,
+// color tables, and masks.
+func TLine(sprite Sprite, x0, y0, x1, y1 int, u, v, du, dv float64) {
+	if sprite.W <= 0 || sprite.H <= 0 {
+		return
+	}
+
+	src, _, _ := sprite.Area.ClippedBy(sprite.Source.EntireArea())
+
+	TLineFunc(x0, y0, x1, y1, u, v, du, dv, func(u, v float64) (Color, bool) {
+		sx := wrap(int(math.Floor(u)), sprite.W)
+		sy := wrap(int(math.Floor(v)), sprite.H)
+		if sprite.FlipX {
+			sx = sprite.W - 1 - sx
+		}
+		if sprite.FlipY {
+			sy = sprite.H - 1 - sy
+		}
+		sx += sprite.X
+		sy += sprite.Y
+		if !src.Contains(sx, sy) {
+			return 0, false
+		}
+		return sprite.Source.data[sy*sprite.Source.width+sx], true
+	})
+}
+
+// TLineFunc is like TLine, but pixels are sampled by calling the sample function
+// with the texture coordinates of each pixel.
+//
+// When sample returns false, the pixel is not drawn.
+//
+// It takes into account the camera position, clipping region,
+// color tables, and masks.
+func TLineFunc(x0, y0, x1, y1 int, u, v, du, dv float64, sample func(u, v float64) (Color, bool)) {
+	dx := x1 - x0
+	dy := y1 - y0
+	steps := max(abs(dx), abs(dy))
+
+	stepX, stepY := 0.0, 0.0
+	if steps > 0 {
+		stepX = float64(dx) / float64(steps)
+		stepY = float64(dy) / float64(steps)
+	}
+
+	for i := 0; i <= steps; i++ {
+		x := x0 + int(math.Floor(float64(i)*stepX+0.5)) - Camera.X
+		y := y0 + int(math.Floor(float64(i)*stepY+0.5)) - Camera.Y
+
+		if clip.Contains(x, y) {
+			if sourceColor, ok := sample(u, v); ok {
+				sourceColor &= ReadMask
+				idx := y*drawTarget.width + x
+				targetColor := drawTarget.data[idx] & TargetMask
+				drawTarget.data[idx] =
+					ColorTables[(sourceColor|targetColor)>>6][sourceColor&(MaxColors-1)][targetColor&(MaxColors-1)]
+			}
+		}
+
+		u += du
+		v += dv
+	}
+}
+
+// Mode7Camera describes a camera looking at the floor plane drawn by Mode7.
+type Mode7Camera struct {
+	// X, Y is the camera position on the floor plane, in texture pixels.
+	X, Y float64
+	// Angle is the direction the camera is looking, in radians.
+	// Angle 0 looks towards increasing X, angle math.Pi/2 towards increasing Y.
+	Angle float64
+	// Height is the camera height above the floor, in texture pixels.
+	Height float64
+	// FocalLength is the distance between the camera and the screen, in screen pixels.
+	// The bigger the value, the narrower the field of view.
+	FocalLength float64
+}
+
+// Mode7 draws a floor plane in perspective (like the SNES Mode 7),
+// using the sprite as an infinitely repeated texture.
+//
+// The floor is drawn line by line inside the area. The horizon
+// is located just above the area's top edge.
+//
+// It takes into account the camera position, clipping region,
+// color tables, and masks.
+func Mode7(texture Sprite, area IntArea, camera Mode7Camera) {
+	mode7(area, camera, func(x0, y, x1 int, u, v, du, dv float64) {
+		TLine(texture, x0, y, x1, y, u, v, du, dv)
+	})
+}
+
+// Mode7Func is like Mode7, but pixels are sampled by calling the sample function
+// with the coordinates on the floor plane.
+//
+// When sample returns false, the pixel is not drawn.
+func Mode7Func(area IntArea, camera Mode7Camera, sample func(u, v float64) (Color, bool)) {
+	mode7(area, camera, func(x0, y, x1 int, u, v, du, dv float64) {
+		TLineFunc(x0, y, x1, y, u, v, du, dv, sample)
+	})
+}
+
+func mode7(area IntArea, camera Mode7Camera, line func(x0, y, x1 int, u, v, du, dv float64)) {
+	if area.W <= 0 || camera.FocalLength <= 0 {
+		return
+	}
+
+	sin, cos := math.Sincos(camera.Angle)
+
+	for row := 0; row < area.H; row++ {
+		// distance to the floor visible in this row (rows are sampled at their centers):
+		distance := camera.Height * camera.FocalLength / (float64(row) + 0.5)
+		// floor plane units per screen pixel:
+		scale := distance / camera.FocalLength
+
+		// the floor point in the middle of the row:
+		centerU := camera.X + cos*distance
+		centerV := camera.Y + sin*distance
+		// the right direction is perpendicular to the looking direction:
+		du := -sin * scale
+		dv := cos * scale
+		halfWidth := float64(area.W)/2 - 0.5
+
+		line(area.X, area.Y+row, area.X+area.W-1,
+			centerU-du*halfWidth, centerV-dv*halfWidth,
+			du, dv)
+	}
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// wrap returns x modulo n, always in range 0..n-1.
+func wrap(x, n int) int {
+	x %= n
+	if x < 0 {
+		x += n
+	}
+	return x
+}
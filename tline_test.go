@@ -0,0 +1,110 @@
+// Copyright 2025 Jacek Olszak
+// This code is licensed under MIT license (see LICENSE for details)
+
+package pi_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/elgopher/pi"
+	"github.com/elgopher/pi/pitest"
+)
+
+func TestTLine(t *testing.T) {
+	pi.ResetColorTables()
+	pi.Camera = pi.Position{}
+
+	texture := pi.NewCanvas(3, 2)
+	texture.SetAll(
+		1, 2, 3,
+		4, 5, 6,
+	)
+	sprite := pi.CanvasSprite(texture)
+
+	t.Run("should draw horizontal line", func(t *testing.T) {
+		pi.SetDrawTarget(pi.NewCanvas(5, 1))
+		// when
+		pi.TLine(sprite, 0, 0, 4, 0, 0, 0, 1, 0)
+		// then
+		expected := pi.NewCanvas(5, 1)
+		expected.SetAll(1, 2, 3, 1, 2)
+		pitest.AssertSurfaceEqual(t, expected, pi.DrawTarget())
+	})
+
+	t.Run("should draw from the first point", func(t *testing.T) {
+		pi.SetDrawTarget(pi.NewCanvas(5, 1))
+		// when
+		pi.TLine(sprite, 4, 0, 0, 0, 0, 1, 1, 0)
+		// then
+		expected := pi.NewCanvas(5, 1)
+		expected.SetAll(5, 4, 6, 5, 4)
+		pitest.AssertSurfaceEqual(t, expected, pi.DrawTarget())
+	})
+
+	t.Run("should draw vertical line sampling texture diagonally with fractions", func(t *testing.T) {
+		pi.SetDrawTarget(pi.NewCanvas(1, 4))
+		// when
+		pi.TLine(sprite, 0, 0, 0, 3, -1, 0.5, 0.5, 0.5)
+		// then
+		expected := pi.NewCanvas(1, 4)
+		expected.SetAll(3, 6, 4, 1)
+		pitest.AssertSurfaceEqual(t, expected, pi.DrawTarget())
+	})
+
+	t.Run("should take flip, camera, clip and color tables into account", func(t *testing.T) {
+		pi.SetDrawTarget(pi.NewCanvas(4, 1))
+		pi.SetClip(pi.IntArea{W: 3, H: 1})
+		pi.Camera = pi.Position{X: -1}
+		defer func() {
+			pi.Camera = pi.Position{}
+		}()
+		pi.SetTransparency(2, true)
+		defer pi.ResetColorTables()
+		// when
+		pi.TLine(sprite.WithFlipX(true), 0, 0, 2, 0, 0, 0, 1, 0)
+		// then
+		expected := pi.NewCanvas(4, 1)
+		expected.SetAll(0, 3, 0, 0)
+		pitest.AssertSurfaceEqual(t, expected, pi.DrawTarget())
+	})
+}
+
+func TestMode7(t *testing.T) {
+	pi.ResetColorTables()
+	pi.Camera = pi.Position{}
+
+	texture := pi.NewCanvas(4, 4)
+	texture.SetAll(
+		1, 1, 1, 1,
+		2, 2, 2, 2,
+		3, 3, 3, 3,
+		4, 4, 4, 4,
+	)
+	sprite := pi.CanvasSprite(texture)
+
+	t.Run("should draw floor visible to the camera", func(t *testing.T) {
+		pi.SetDrawTarget(pi.NewCanvas(3, 2))
+		camera := pi.Mode7Camera{Height: 1, FocalLength: 1}
+		// when
+		pi.Mode7(sprite, pi.IntArea{Y: 1, W: 3, H: 1}, camera)
+		// then
+		expected := pi.NewCanvas(3, 2)
+		expected.SetAll(
+			0, 0, 0,
+			3, 1, 3,
+		)
+		pitest.AssertSurfaceEqual(t, expected, pi.DrawTarget())
+	})
+
+	t.Run("should rotate camera", func(t *testing.T) {
+		pi.SetDrawTarget(pi.NewCanvas(3, 1))
+		camera := pi.Mode7Camera{X: 0.5, Y: 0.5, Height: 1, FocalLength: 1, Angle: math.Pi / 2}
+		// when
+		pi.Mode7(sprite, pi.IntArea{W: 3, H: 1}, camera)
+		// then
+		expected := pi.NewCanvas(3, 1)
+		expected.SetAll(3, 3, 3)
+		pitest.AssertSurfaceEqual(t, expected, pi.DrawTarget())
+	})
+}